@@ -0,0 +1,20 @@
+resource "incident_workflow" "page_on_call" {
+  # ...
+}
+
+# Would the workflow, as configured, have fired on last week's SEV1? Run:
+#
+#   terraform apply -invoke=action.incident_workflow_test_run.last_sev1
+#
+# to see each condition's outcome and what each step's params would resolve to.
+action "incident_workflow_test_run" "last_sev1" {
+  config {
+    incident_id      = "01HXVEA7Y0VWQBJB4F2X8WNRW6"
+    condition_groups = incident_workflow.page_on_call.condition_groups
+    expressions      = incident_workflow.page_on_call.expressions
+    steps            = incident_workflow.page_on_call.steps
+
+    # Fail the invocation if the workflow wouldn't have fired.
+    expect_match = true
+  }
+}
@@ -0,0 +1,6 @@
+data "incident_identity" "current" {}
+
+output "organisation" {
+  description = "The organisation this configuration applies to"
+  value       = data.incident_identity.current.organisation_id
+}
@@ -0,0 +1,60 @@
+# Who is on call for the primary schedule right now.
+data "incident_schedule_entries" "now" {
+  schedule_id = incident_schedule.primary_on_call.id
+  from        = plantimestamp()
+  to          = timeadd(plantimestamp(), "1m")
+}
+
+output "current_on_call" {
+  description = "The IDs of the users on call right now"
+  value       = [for entry in data.incident_schedule_entries.now.final : entry.user_id]
+}
+
+# Preview the next four weeks with a proposed rotation change, and fail the plan if it
+# would leave anyone without cover. This doesn't change the saved schedule.
+locals {
+  proposed_rotations = [
+    {
+      id   = "primary"
+      name = "Primary"
+      versions = [
+        {
+          handover_start_at = "2026-01-05T09:00:00Z"
+          handovers = [
+            {
+              interval      = 1
+              interval_type = "weekly"
+            }
+          ]
+          users = [
+            data.incident_user.rory.id,
+            data.incident_user.lisa.id,
+          ]
+          layers = [
+            {
+              id   = "primary"
+              name = "Primary"
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+
+data "incident_schedule_entries" "proposed" {
+  schedule_id = incident_schedule.primary_on_call.id
+  from        = plantimestamp()
+  to          = timeadd(plantimestamp(), "672h")
+
+  preview = {
+    rotations = local.proposed_rotations
+  }
+}
+
+check "proposed_schedule_has_no_gaps" {
+  assert {
+    condition     = length(data.incident_schedule_entries.proposed.gaps) == 0
+    error_message = "The proposed rotations leave gaps in cover: ${jsonencode(data.incident_schedule_entries.proposed.gaps)}"
+  }
+}
@@ -0,0 +1,17 @@
+# Every override on the schedule's primary rotation that overlaps with August.
+data "incident_schedule_overrides" "august" {
+  schedule_id = incident_schedule.primary_on_call.id
+  rotation_id = "primary"
+
+  from = "2026-08-01T00:00:00Z"
+  to   = "2026-09-01T00:00:00Z"
+}
+
+output "august_cover" {
+  description = "Who is covering, and when"
+  value = [for override in data.incident_schedule_overrides.august.overrides : {
+    user_id  = override.user_id
+    start_at = override.start_at
+    end_at   = override.end_at
+  }]
+}
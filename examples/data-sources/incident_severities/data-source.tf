@@ -0,0 +1,42 @@
+data "incident_severities" "main" {}
+
+# Refer to severities by name in workflow conditions, rather than hard-coding their IDs.
+resource "incident_workflow" "critical_incidents" {
+  name    = "Notify leadership of critical incidents"
+  trigger = "incident.updated"
+  expressions = [
+  ]
+  condition_groups = [
+    {
+      conditions = [
+        {
+          # "Incident → Severity"
+          subject   = "incident.severity"
+          operation = "one_of"
+          param_bindings = [
+            {
+              array_value = [
+                {
+                  literal = data.incident_severities.main.all["Critical"].id
+                },
+              ]
+            },
+          ]
+        },
+      ]
+    },
+  ]
+  steps = [
+  ]
+  once_for = [
+    # "Incident"
+    "incident",
+  ]
+  include_private_incidents = false
+  continue_on_step_error    = false
+  runs_on_incidents         = "newly_created_and_active"
+  runs_on_incident_modes = [
+    "standard",
+  ]
+  state = "draft"
+}
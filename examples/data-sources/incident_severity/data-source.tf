@@ -0,0 +1,9 @@
+# Look a severity up by name...
+data "incident_severity" "critical" {
+  name = "Critical"
+}
+
+# ...or by rank, where higher ranks are more severe.
+data "incident_severity" "least_severe" {
+  rank = 1
+}
@@ -0,0 +1,9 @@
+# Look a status up by name...
+data "incident_status" "monitoring" {
+  name = "Monitoring"
+}
+
+# ...or by category, for categories that only hold one status.
+data "incident_status" "triage" {
+  category = "triage"
+}
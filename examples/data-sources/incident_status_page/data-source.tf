@@ -0,0 +1,7 @@
+data "incident_status_page" "main" {
+  name = "Acme status"
+}
+
+output "status_page_url" {
+  value = data.incident_status_page.main.public_url
+}
@@ -0,0 +1,14 @@
+data "incident_status_page" "main" {
+  name = "Acme status"
+}
+
+data "incident_status_page_structure" "main" {
+  status_page_id = data.incident_status_page.main.id
+}
+
+# Bind components by name in workflow steps that publish status page updates, instead
+# of hard-coding their IDs.
+locals {
+  api_component_id       = data.incident_status_page_structure.main.components["API"].id
+  platform_component_ids = data.incident_status_page_structure.main.groups["Platform"].component_ids
+}
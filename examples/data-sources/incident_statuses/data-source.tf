@@ -0,0 +1,8 @@
+# Every status an active incident can be in, keyed by name.
+data "incident_statuses" "live" {
+  category = "live"
+}
+
+output "live_status_ids" {
+  value = { for name, status in data.incident_statuses.live.all : name => status.id }
+}
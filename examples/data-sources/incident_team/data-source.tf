@@ -0,0 +1,15 @@
+# Refer to a team by name. If the team is renamed or deleted, the plan fails rather
+# than silently dropping ownership.
+data "incident_team" "platform" {
+  name = "Platform"
+}
+
+resource "incident_schedule" "platform_on_call" {
+  name     = "Platform on-call"
+  timezone = "Europe/London"
+  team_ids = [data.incident_team.platform.id]
+
+  rotations = [
+    # ...
+  ]
+}
@@ -0,0 +1,9 @@
+data "incident_teams" "all" {}
+
+output "team_members" {
+  description = "The email addresses of each team's members, keyed by team name"
+  value = {
+    for team in data.incident_teams.all.teams :
+    team.name => [for member in team.members : member.email]
+  }
+}
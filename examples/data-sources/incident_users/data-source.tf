@@ -0,0 +1,32 @@
+# Everyone active with an example.com email address, and how they get paged.
+data "incident_users" "engineers" {
+  is_active                    = true
+  email_domain                 = "example.com"
+  include_notification_methods = true
+}
+
+# Build a rotation from the group, rather than listing each user by hand.
+output "engineer_ids" {
+  description = "The IDs of every active engineer, for a rotation's users"
+  value       = [for user in data.incident_users.engineers.users : user.id]
+}
+
+# Flag anyone in the group who couldn't be phoned if they were paged.
+locals {
+  engineers_without_phone = [
+    for user in data.incident_users.engineers.users : user.email
+    if !anytrue([for method in user.notification_methods : method.method_type == "phone" && method.is_usable])
+  ]
+}
+
+check "engineers_have_a_phone_method" {
+  assert {
+    condition     = length(local.engineers_without_phone) == 0
+    error_message = "These engineers have no verified phone number to be paged on: ${join(", ", local.engineers_without_phone)}"
+  }
+}
+
+# Every administrator, active or not.
+data "incident_users" "administrators" {
+  role = "administrator"
+}
@@ -0,0 +1,34 @@
+resource "incident_workflow" "page_on_call" {
+  # ...
+}
+
+# The last ten runs of the workflow since it was last changed.
+data "incident_workflow_runs" "page_on_call" {
+  workflow_id   = incident_workflow.page_on_call.id
+  created_after = "2024-05-01T00:00:00Z"
+  limit         = 10
+}
+
+# Warn on every plan and apply if any of them failed, with the step that failed and why.
+locals {
+  page_on_call_failures = flatten([
+    for run in data.incident_workflow_runs.page_on_call.runs : [
+      for step in run.steps : "${run.incident_reference}: ${step.step}: ${step.error}"
+      if step.status == "error"
+    ]
+    if run.status == "error"
+  ])
+}
+
+check "page_on_call_runs_succeed" {
+  assert {
+    condition     = length(local.page_on_call_failures) == 0
+    error_message = "Recent runs of the workflow failed:\n${join("\n", local.page_on_call_failures)}"
+  }
+}
+
+# The most recent failed run of any workflow.
+data "incident_workflow_runs" "last_failure" {
+  status = "error"
+  limit  = 1
+}
@@ -0,0 +1,13 @@
+# Create a key that only lives for this Terraform run, to configure another
+# provider with read access to the catalog. It's deleted once the run is done.
+ephemeral "incident_api_key" "catalog_reader" {
+  name       = "Terraform run: catalog reader"
+  role_names = ["catalog_viewer"]
+}
+
+provider "restapi" {
+  uri = "https://api.incident.io"
+  headers = {
+    Authorization = "Bearer ${ephemeral.incident_api_key.catalog_reader.token}"
+  }
+}
@@ -0,0 +1,22 @@
+resource "incident_api_key" "ci" {
+  name       = "CI catalog importer"
+  role_names = ["catalog_viewer", "catalog_editor"]
+
+  # Change this to rotate the key on the next apply.
+  rotation_trigger     = "2025-01"
+  grace_period_minutes = 30
+}
+
+# Read the token issued when the key was created or rotated in this apply, and hand it
+# straight to a write-only argument, so it never touches plan or state. In any other run
+# the token is null, and the secret is left as it is.
+ephemeral "incident_api_key_token" "ci" {
+  api_key_id           = incident_api_key.ci.id
+  token_last_issued_at = incident_api_key.ci.token_last_issued_at
+}
+
+resource "incident_secret" "ci_token" {
+  name             = "CI catalog importer token"
+  value_wo         = ephemeral.incident_api_key_token.ci.token
+  rotation_trigger = incident_api_key.ci.token_last_issued_at
+}
@@ -0,0 +1,9 @@
+# Find every alert source in your organisation, then write import blocks and config
+# for them with:
+#
+#   terraform query -generate-config-out=generated.tf
+list "incident_alert_source" "all" {
+  provider = incident
+
+  include_resource = true
+}
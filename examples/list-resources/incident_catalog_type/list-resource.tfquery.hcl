@@ -0,0 +1,9 @@
+# Find every catalog type in your organisation, then write import blocks and config
+# for them with:
+#
+#   terraform query -generate-config-out=generated.tf
+list "incident_catalog_type" "all" {
+  provider = incident
+
+  include_resource = true
+}
@@ -0,0 +1,11 @@
+# Find every incident role in your organisation, then write import blocks and config for
+# them with:
+#
+#   terraform query -generate-config-out=generated.tf
+#
+# The built-in incident lead and reporter roles can't be managed, so aren't listed.
+list "incident_incident_role" "all" {
+  provider = incident
+
+  include_resource = true
+}
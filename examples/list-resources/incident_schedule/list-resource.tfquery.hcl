@@ -0,0 +1,9 @@
+# Find every schedule in your organisation, then write import blocks and config
+# for them with:
+#
+#   terraform query -generate-config-out=generated.tf
+list "incident_schedule" "all" {
+  provider = incident
+
+  include_resource = true
+}
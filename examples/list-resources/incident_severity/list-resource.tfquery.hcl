@@ -0,0 +1,9 @@
+# Find every severity in your organisation, then write import blocks and config
+# for them with:
+#
+#   terraform query -generate-config-out=generated.tf
+list "incident_severity" "all" {
+  provider = incident
+
+  include_resource = true
+}
@@ -0,0 +1,11 @@
+# Find every status in your organisation, then write import blocks and config
+# for them with:
+#
+#   terraform query -generate-config-out=generated.tf
+#
+# Built-in statuses, such as Triage and Declined, can't be managed, so aren't listed.
+list "incident_status" "all" {
+  provider = incident
+
+  include_resource = true
+}
@@ -0,0 +1,12 @@
+# Create an API key for our CI pipeline, able to view and manage catalog data.
+resource "incident_api_key" "ci" {
+  name     = "CI catalog importer"
+  comments = "Used by the catalog-importer job in CI"
+
+  role_names = ["catalog_viewer", "catalog_editor"]
+
+  # Change this to rotate the key, issuing a new token. The previous token keeps
+  # working for grace_period_minutes.
+  rotation_trigger     = "2025-01"
+  grace_period_minutes = 30
+}
@@ -0,0 +1,9 @@
+# Import using the resource's identity, rather than an import ID
+# Replace the IDs with real IDs from your incident.io organization
+import {
+  to = incident_catalog_type_attribute.example
+  identity = {
+    catalog_type_id = "01ABC123DEF456GHI789JKL"
+    id              = "01MNO456PQR789STU012VWX"
+  }
+}
@@ -0,0 +1,7 @@
+# There is only one IP allowlist per organization, so its identity is always the same
+import {
+  to = incident_ip_allowlist.this
+  identity = {
+    id = "ip_allowlist"
+  }
+}
@@ -0,0 +1,5 @@
+# There is only one IP allowlist per organization, so any ID will do
+import {
+  to = incident_ip_allowlist.this
+  id = "ip_allowlist"
+}
@@ -0,0 +1,4 @@
+#!/bin/bash
+
+# There is only one IP allowlist per organization, so any ID will do
+terraform import incident_ip_allowlist.this ip_allowlist
\ No newline at end of file
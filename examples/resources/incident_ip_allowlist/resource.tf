@@ -0,0 +1,15 @@
+# Only allow access to incident.io from our offices and VPN.
+resource "incident_ip_allowlist" "this" {
+  enabled = true
+
+  allowlist = [
+    {
+      value = "192.0.2.0/24"
+      label = "London HQ"
+    },
+    {
+      value = "198.51.100.7"
+      label = "Corporate VPN"
+    },
+  ]
+}
@@ -0,0 +1,7 @@
+# Import an override using its schedule's ID and its own ID, separated by a colon.
+# Overrides can only be listed through the schedule that holds them.
+# Replace both IDs with real ones from your incident.io organization.
+import {
+  to = incident_schedule_override.example
+  id = "01ABC123DEF456GHI789JKL:01MNO456PQR789STU012VWX"
+}
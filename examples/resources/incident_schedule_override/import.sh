@@ -0,0 +1,6 @@
+#!/bin/bash
+
+# Import an override using its schedule's ID and its own ID, separated by a colon.
+# Overrides can only be listed through the schedule that holds them.
+# Replace both IDs with real ones from your incident.io organization.
+terraform import incident_schedule_override.example 01ABC123DEF456GHI789JKL:01MNO456PQR789STU012VWX
\ No newline at end of file
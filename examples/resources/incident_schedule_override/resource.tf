@@ -0,0 +1,14 @@
+data "incident_user" "cover" {
+  email = "lisa@incident.io"
+}
+
+# Lisa covers the primary layer while the usual on-caller is on holiday.
+resource "incident_schedule_override" "summer_holiday" {
+  schedule_id = incident_schedule.primary_on_call.id
+  rotation_id = "primary"
+  layer_id    = "primary"
+  user_id     = data.incident_user.cover.id
+
+  start_at = "2026-08-03T09:00:00+01:00"
+  end_at   = "2026-08-17T09:00:00+01:00"
+}
@@ -0,0 +1,7 @@
+# Import a replica using its schedule's ID and its own ID, separated by a colon.
+# Replicas can only be looked up through the schedule they mirror.
+# Replace both IDs with real ones from your incident.io organization.
+import {
+  to = incident_schedule_replica.example
+  id = "01ABC123DEF456GHI789JKL:01MNO456PQR789STU012VWX"
+}
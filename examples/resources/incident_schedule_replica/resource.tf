@@ -0,0 +1,16 @@
+# Keep the PagerDuty schedule that existing services still page through in step with
+# the primary layer of the incident.io schedule while you migrate.
+resource "incident_schedule_replica" "primary_to_pagerduty" {
+  schedule_id              = incident_schedule.primary_on_call.id
+  replica_provider         = "pagerduty"
+  replica_provider_id      = "PO8107X"
+  replica_fallback_user_id = "PA7AXXN"
+  mirror_window_days       = 14
+
+  sources = [
+    {
+      rotation_id = "primary"
+      layer_id    = "primary"
+    },
+  ]
+}
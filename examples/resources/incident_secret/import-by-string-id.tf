@@ -0,0 +1,6 @@
+# Import a secret using its ID
+# Replace the ID with a real ID from your incident.io organization
+import {
+  to = incident_secret.example
+  id = "01ABC123DEF456GHI789JKL"
+}
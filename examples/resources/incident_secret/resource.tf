@@ -0,0 +1,17 @@
+variable "pagerduty_webhook_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Store the token our outgoing PagerDuty webhook authenticates with. The value is
+# write-only, so it never ends up in state.
+resource "incident_secret" "pagerduty_webhook" {
+  name        = "PagerDuty webhook token"
+  description = "Auth token for the PagerDuty outgoing webhook"
+
+  value_wo = var.pagerduty_webhook_token
+
+  # Bump this whenever the token changes, to rotate the secret to the new value.
+  rotation_trigger = "2026-10-01"
+}
@@ -0,0 +1,6 @@
+# Import a status page maintenance using its ID
+# Replace the ID with a real ID from your incident.io organization
+import {
+  to = incident_status_page_maintenance.example
+  id = "01ABC123DEF456GHI789JKL"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import a status page maintenance using its ID
+# Replace the ID with a real ID from your incident.io organization
+terraform import incident_status_page_maintenance.example 01ABC123DEF456GHI789JKL
\ No newline at end of file
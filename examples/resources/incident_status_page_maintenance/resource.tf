@@ -0,0 +1,48 @@
+data "incident_user" "ops_lead" {
+  email = "ops-lead@example.com"
+}
+
+data "incident_status_page" "main" {
+  name = "Acme Status"
+}
+
+data "incident_status_page_structure" "main" {
+  status_page_id = data.incident_status_page.main.id
+}
+
+resource "incident_maintenance_window" "database_migration" {
+  name     = "Scheduled Database Migration"
+  start_at = "2026-04-01T02:00:00Z"
+  end_at   = "2026-04-01T06:00:00Z"
+  lead_id  = data.incident_user.ops_lead.id
+}
+
+# Publish the maintenance window to the status page. Moving the window moves the
+# public notice with it.
+resource "incident_status_page_maintenance" "database_migration" {
+  status_page_id        = data.incident_status_page.main.id
+  name                  = "Database maintenance"
+  maintenance_window_id = incident_maintenance_window.database_migration.id
+
+  affected_component_ids = [
+    data.incident_status_page_structure.main.components["API"].id,
+    data.incident_status_page_structure.main.components["Dashboard"].id,
+  ]
+
+  message = <<-EOT
+    We're upgrading our primary database. The **API** and **Dashboard** may be
+    slow to respond for a few minutes during the window.
+  EOT
+
+  notify_subscribers = true
+}
+
+# Or set the time window directly.
+resource "incident_status_page_maintenance" "network_upgrade" {
+  status_page_id         = data.incident_status_page.main.id
+  name                   = "Network upgrade"
+  affected_component_ids = [data.incident_status_page_structure.main.components["API"].id]
+  start_at               = "2026-05-01T22:00:00Z"
+  end_at                 = "2026-05-01T23:00:00Z"
+  message                = "We're upgrading our network. You may see brief connection resets."
+}
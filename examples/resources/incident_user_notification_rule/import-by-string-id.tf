@@ -0,0 +1,6 @@
+# Import a notification rule using the ID of its user and the ID of the rule, separated by a colon
+# Replace the IDs with real IDs from your incident.io organization
+import {
+  to = incident_user_notification_rule.example
+  id = "01ABC123DEF456GHI789JKL:01MNO123PQR456STU789VWX"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import a notification rule using the ID of its user and the ID of the rule, separated by a colon
+# Replace the IDs with real IDs from your incident.io organization
+terraform import incident_user_notification_rule.example 01ABC123DEF456GHI789JKL:01MNO123PQR456STU789VWX
\ No newline at end of file
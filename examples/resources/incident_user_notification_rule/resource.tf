@@ -0,0 +1,21 @@
+data "incident_user" "lisa" {
+  email = "lisa@example.com"
+}
+
+# A critical push notification to every device straight away...
+resource "incident_user_notification_rule" "push" {
+  user_id                       = data.incident_user.lisa.id
+  rule_type                     = "high_urgency"
+  method_type                   = "app"
+  delay_seconds                 = 0
+  push_notification_criticality = "critical"
+}
+
+# ...then a phone call if it hasn't been acknowledged after two minutes.
+resource "incident_user_notification_rule" "call" {
+  user_id       = data.incident_user.lisa.id
+  rule_type     = "high_urgency"
+  method_type   = "phone"
+  delay_seconds = 120
+  phone_channel = "voice"
+}
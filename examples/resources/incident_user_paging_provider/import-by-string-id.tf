@@ -0,0 +1,6 @@
+# Import a user's paging provider using the ID of the user
+# Replace the ID with a real ID from your incident.io organization
+import {
+  to = incident_user_paging_provider.example
+  id = "01ABC123DEF456GHI789JKL"
+}
@@ -0,0 +1,5 @@
+#!/bin/bash
+
+# Import a user's paging provider using the ID of the user
+# Replace the ID with a real ID from your incident.io organization
+terraform import incident_user_paging_provider.example 01ABC123DEF456GHI789JKL
\ No newline at end of file
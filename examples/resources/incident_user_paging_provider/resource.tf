@@ -0,0 +1,22 @@
+# Move the first batch of responders onto incident.io paging, leaving everyone else on
+# PagerDuty until their turn.
+data "incident_users" "platform" {
+  is_active    = true
+  email_domain = "example.com"
+}
+
+locals {
+  migrated_emails = toset([
+    "lisa@example.com",
+    "rory@example.com",
+  ])
+}
+
+resource "incident_user_paging_provider" "platform" {
+  for_each = {
+    for user in data.incident_users.platform.users : user.email => user.id
+  }
+
+  user_id                       = each.value
+  preferred_escalation_provider = contains(local.migrated_emails, each.key) ? "native" : "pagerduty"
+}
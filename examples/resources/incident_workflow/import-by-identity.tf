@@ -0,0 +1,8 @@
+# Import using the resource's identity, rather than an import ID
+# Replace the ID with a real ID from your incident.io organization
+import {
+  to = incident_workflow.example
+  identity = {
+    id = "01ABC123DEF456GHI789JKL"
+  }
+}
@@ -0,0 +1,6 @@
+# Import a workflow using its ID
+# Replace the ID with a real ID from your incident.io organization
+import {
+  to = incident_workflow_beta.example
+  id = "01ABC123DEF456GHI789JKL"
+}
@@ -0,0 +1,55 @@
+# This workflow asks for a postmortem once an incident with more than three
+# participants is closed.
+resource "incident_workflow_beta" "request_postmortem" {
+  name    = "Request a postmortem"
+  trigger = "incident.updated"
+
+  conditions = [
+    {
+      subject   = "incident.status.category"
+      operation = "one_of"
+      params    = [{ values = ["closed"] }]
+    },
+    {
+      subject   = "expressions[\"participant_count\"]"
+      operation = "greater_than"
+      params    = [{ value_literal = "3" }]
+    },
+  ]
+
+  named_expression {
+    name       = "participant_count"
+    label      = "Count active participants"
+    start_from = "incident.active_participants"
+
+    operation {
+      count = {}
+    }
+  }
+
+  step {
+    # This is the ID of the step in the workflow, and must be a ULID.
+    id   = "01HXVEA7Y0VWQBJB4F2X8WNRW6"
+    name = "incident.create_follow_ups"
+    param_bindings = [
+      { value_reference = "incident" },
+      { rich_text = "Write the postmortem for {{ incident.name }}" },
+      # Skip the optional follow-up description.
+      {},
+    ]
+  }
+
+  once_for               = ["incident"]
+  private_incident_scope = "none"
+  continue_on_step_error = false
+  runs_on_incidents      = "newly_created"
+  runs_on_incident_modes = ["standard"]
+  state                  = "active"
+}
+
+# Move an existing incident_workflow here without recreating it. Needs
+# Terraform 1.8 or later.
+moved {
+  from = incident_workflow.request_postmortem
+  to   = incident_workflow_beta.request_postmortem
+}
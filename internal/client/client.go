@@ -5,27 +5,87 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
+	"path"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/oapi-codegen/oapi-codegen/v2/pkg/securityprovider"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 )
 
+// Policy is how the client retries failed requests, and paces the requests it makes.
+type Policy struct {
+	// MaxRetries is how many times a request is retried after a rate limit or a server
+	// error.
+	MaxRetries int
+	// MinBackoff and MaxBackoff bound the wait between retries, unless the API asks us to
+	// wait longer with a Retry-After header.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+	// RequestTimeout limits each attempt at a request, or is zero for no limit.
+	RequestTimeout time.Duration
+	// RequestsPerSecond limits how quickly requests are sent, or is zero for no limit.
+	RequestsPerSecond float64
+}
+
+// DefaultPolicy is the policy of a client built with New.
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxRetries: 10,
+		MinBackoff: 1 * time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+}
+
 func New(ctx context.Context, apiKey, apiEndpoint, version string, opts ...ClientOption) (*ClientWithResponses, error) {
+	return NewWithPolicy(ctx, apiKey, apiEndpoint, version, DefaultPolicy(), opts...)
+}
+
+// NewWithPolicy builds a client that retries and paces its requests by the given policy.
+func NewWithPolicy(ctx context.Context, apiKey, apiEndpoint, version string, policy Policy, opts ...ClientOption) (*ClientWithResponses, error) {
 	bearerTokenProvider, bearerTokenProviderErr := securityprovider.NewSecurityProviderBearerToken(apiKey)
 	if bearerTokenProviderErr != nil {
 		return nil, bearerTokenProviderErr
 	}
 
 	retryClient := retryablehttp.NewClient()
-	retryClient.RetryMax = maxRetries
+	retryClient.RetryMax = policy.MaxRetries
+	retryClient.RetryWaitMin = policy.MinBackoff
+	retryClient.RetryWaitMax = policy.MaxBackoff
 	retryClient.Backoff = attentiveBackoff
+	retryClient.HTTPClient.Timeout = policy.RequestTimeout
+
+	// Every attempt at a request waits its turn, retries included, so a large apply
+	// stays under the rate limit rather than backing off from it.
+	if policy.RequestsPerSecond > 0 {
+		limiter := rate.NewLimiter(rate.Limit(policy.RequestsPerSecond), int(math.Max(1, math.Ceil(policy.RequestsPerSecond))))
+		retryClient.HTTPClient.Transport = Wrap(retryClient.HTTPClient.Transport, func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+			if err := limiter.Wait(req.Context()); err != nil {
+				return nil, err
+			}
+
+			return next.RoundTrip(req)
+		})
+	}
 
 	base := retryClient.StandardClient()
 
+	// Tests can record the responses to their requests, or replay them offline. Either
+	// sits above retries, so a cassette holds only the response each request ended with.
+	recording, err := recordingFromEnv()
+	if err != nil {
+		return nil, errors.Wrap(err, "setting up recording")
+	}
+	if recording != nil {
+		base.Transport = recording(base.Transport)
+	}
+
 	// The generated client won't turn validation errors into actual errors, so we do this
 	// inside of a generic middleware.
 	base.Transport = Wrap(base.Transport, func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
@@ -77,43 +137,54 @@ func New(ctx context.Context, apiKey, apiEndpoint, version string, opts ...Clien
 	return client, nil
 }
 
-const (
-	maxRetries = 10
-)
-
 func attentiveBackoff(minDuration, maxDuration time.Duration, attemptNum int, resp *http.Response) time.Duration {
-	// Retry for rate limits and server errors.
-	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
-		// Check for a 'Retry-After' header.
-		retryAfter := resp.Header.Get("Retry-After")
-		if retryAfter != "" {
-			retryAfterDate, err := time.Parse(time.RFC1123, retryAfter)
-			if err != nil {
-				// If we can't parse the Retry-After, lets just wait for 10 seconds
-				return 10 * time.Second
-			}
-
-			timeToWait := time.Until(retryAfterDate)
-
-			if timeToWait < 1*time.Second {
-				// by default lets back off at least 1 second
-				return 1 * time.Second
+	// Rate limits and unavailable servers may tell us how long to wait.
+	if resp != nil && (resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable) {
+		if timeToWait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			if timeToWait < minDuration {
+				return minDuration
 			}
 
 			return timeToWait
 		}
-
 	}
+
 	// otherwise use the default backoff
 	return retryablehttp.DefaultBackoff(minDuration, maxDuration, attemptNum, resp)
 }
 
+// parseRetryAfter reads a Retry-After header, which is either a number of seconds to
+// wait or the date to wait until.
+func parseRetryAfter(retryAfter string, now time.Time) (time.Duration, bool) {
+	if retryAfter == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(retryAfter); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(retryAfter); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+
+	return 0, false
+}
+
+// readOnlyActions are the POST actions that only work something out from what they're
+// sent, such as validating config at plan time, so a read-only client still makes them.
+var readOnlyActions = []string{"validate", "preview_entries", "preview_rollout", "parse_markdown"}
+
 // WithReadOnly restricts the client to GET requests only, useful when creating a client
-// for the purpose of dry-running.
+// for the purpose of dry-running. Actions that don't change anything are allowed too, so
+// that a plan still works.
 func WithReadOnly() ClientOption {
 	return WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+		if req.Method == http.MethodPost && path.Base(path.Dir(req.URL.Path)) == "actions" && slices.Contains(readOnlyActions, path.Base(req.URL.Path)) {
+			return nil
+		}
 		if req.Method != http.MethodGet {
-			return fmt.Errorf("read-only client tried to make mutating request: %s %s", req.Method, req.URL.String())
+			return ReadOnlyError{Method: req.Method, Path: req.URL.Path}
 		}
 
 		return nil
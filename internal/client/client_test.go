@@ -0,0 +1,106 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name       string
+		retryAfter string
+		want       time.Duration
+		ok         bool
+	}{
+		{name: "delta-seconds", retryAfter: "30", want: 30 * time.Second, ok: true},
+		{name: "an HTTP date", retryAfter: "Wed, 01 Jan 2025 12:00:45 GMT", want: 45 * time.Second, ok: true},
+		{name: "an HTTP date in the past", retryAfter: "Wed, 01 Jan 2025 11:59:00 GMT", want: 0, ok: true},
+		{name: "negative seconds", retryAfter: "-5", ok: false},
+		{name: "neither", retryAfter: "soon", ok: false},
+		{name: "missing", retryAfter: "", ok: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tc.retryAfter, now)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAttentiveBackoff(t *testing.T) {
+	rateLimited := func(retryAfter string) *http.Response {
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{retryAfter}}}
+	}
+
+	t.Run("waits as long as Retry-After asks, even beyond the maximum", func(t *testing.T) {
+		assert.Equal(t, 2*time.Minute, attentiveBackoff(time.Second, 30*time.Second, 1, rateLimited("120")))
+	})
+
+	t.Run("waits at least the minimum", func(t *testing.T) {
+		assert.Equal(t, 5*time.Second, attentiveBackoff(5*time.Second, 30*time.Second, 1, rateLimited("0")))
+	})
+
+	t.Run("backs off exponentially without a Retry-After it understands", func(t *testing.T) {
+		assert.Equal(t, 4*time.Second, attentiveBackoff(time.Second, 30*time.Second, 2, rateLimited("soon")))
+	})
+}
+
+func TestNewWithPolicy(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("retries as many times as the policy allows", func(t *testing.T) {
+		attempts = 0
+		apiClient, err := NewWithPolicy(context.Background(), "test-key", server.URL, "test", Policy{
+			MaxRetries: 2,
+			MinBackoff: time.Millisecond,
+			MaxBackoff: time.Millisecond,
+		})
+		if err != nil {
+			t.Fatalf("building client: %v", err)
+		}
+
+		_, err = apiClient.SeveritiesV1ListWithResponse(context.Background())
+
+		assert.Error(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("paces requests to the rate limit", func(t *testing.T) {
+		attempts = 0
+		apiClient, err := NewWithPolicy(context.Background(), "test-key", server.URL, "test", Policy{
+			MaxRetries:        3,
+			MinBackoff:        time.Millisecond,
+			MaxBackoff:        time.Millisecond,
+			RequestsPerSecond: 20,
+		})
+		if err != nil {
+			t.Fatalf("building client: %v", err)
+		}
+
+		// The bucket starts with a burst of 20, so drain it first.
+		for range 5 {
+			_, _ = apiClient.SeveritiesV1ListWithResponse(context.Background())
+		}
+		start := time.Now()
+		_, _ = apiClient.SeveritiesV1ListWithResponse(context.Background())
+
+		assert.Equal(t, 24, attempts)
+		assert.GreaterOrEqual(t, time.Since(start), 150*time.Millisecond, "four attempts at 20 a second")
+	})
+}
@@ -3,21 +3,91 @@ package client
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
+// ReadOnlyError is returned for a request that a client built WithReadOnly refused to
+// send, as it would have made a change.
+type ReadOnlyError struct {
+	Method string
+	Path   string
+}
+
+func (e ReadOnlyError) Error() string {
+	return fmt.Sprintf("read-only mode is on, so the %s %s request that would make this change wasn't sent", e.Method, e.Path)
+}
+
 type HTTPError struct {
 	StatusCode int
 	Body       []byte
 }
 
-// Error returns a string representation of a failed HTTP request. It will try
-// to present the response as pretty-printed JSON first, and will fall back to
-// showing the response as a string if that's not possible.
+// ErrorEnvelope is the body of an error response from the incident.io API.
+type ErrorEnvelope struct {
+	Type      string        `json:"type"`
+	Status    int           `json:"status"`
+	RequestID string        `json:"request_id"`
+	Errors    []ErrorDetail `json:"errors"`
+}
+
+// ErrorDetail is one of the problems an error response lists.
+type ErrorDetail struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Source  *ErrorSource `json:"source,omitempty"`
+}
+
+// ErrorSource is the part of the request an ErrorDetail is about.
+type ErrorSource struct {
+	// Field is the path to the field in the request body, such as `name` or
+	// `escalation_config.auto_cancel_escalations`.
+	Field string `json:"field"`
+}
+
+// Field is the path to the field the error is about, or empty if it isn't about one.
+func (d ErrorDetail) Field() string {
+	if d.Source == nil {
+		return ""
+	}
+
+	return d.Source.Field
+}
+
+// Summary is the error's message, or its code if it has no message.
+func (d ErrorDetail) Summary() string {
+	if d.Message != "" {
+		return d.Message
+	}
+
+	return d.Code
+}
+
+// Envelope parses the response body as the API's error envelope, returning false if it
+// isn't one, such as when a proxy in front of the API failed the request.
+func (e HTTPError) Envelope() (ErrorEnvelope, bool) {
+	var envelope ErrorEnvelope
+	if err := json.Unmarshal(e.Body, &envelope); err != nil {
+		return ErrorEnvelope{}, false
+	}
+	if envelope.Type == "" && len(envelope.Errors) == 0 {
+		return ErrorEnvelope{}, false
+	}
+
+	return envelope, true
+}
+
+// Error returns a string representation of a failed HTTP request. An error from the API
+// is summarised from its envelope, listing each problem with the field it's about, and
+// anything else is shown as pretty-printed JSON if it can be, or as it is if not.
 func (e HTTPError) Error() string {
 	if len(e.Body) == 0 {
 		return fmt.Sprintf("status %d: empty response body", e.StatusCode)
 	}
 
+	if envelope, ok := e.Envelope(); ok {
+		return e.envelopeError(envelope)
+	}
+
 	if jsonStr, err := e.bodyAsJSON(); err == nil {
 		return fmt.Sprintf("status %d:\n\n%s", e.StatusCode, string(jsonStr))
 	}
@@ -25,6 +95,31 @@ func (e HTTPError) Error() string {
 	return fmt.Sprintf("status %d: %s", e.StatusCode, string(e.Body))
 }
 
+func (e HTTPError) envelopeError(envelope ErrorEnvelope) string {
+	message := fmt.Sprintf("status %d", e.StatusCode)
+	if envelope.Type != "" {
+		message += fmt.Sprintf(" (%s)", envelope.Type)
+	}
+
+	problems := []string{}
+	for _, detail := range envelope.Errors {
+		if field := detail.Field(); field != "" {
+			problems = append(problems, fmt.Sprintf("%s: %s", field, detail.Summary()))
+		} else {
+			problems = append(problems, detail.Summary())
+		}
+	}
+	if len(problems) > 0 {
+		message += ": " + strings.Join(problems, "; ")
+	}
+
+	if envelope.RequestID != "" {
+		message += fmt.Sprintf(" (request ID %s)", envelope.RequestID)
+	}
+
+	return message
+}
+
 // bodyAsJSON attempts to format the HTTP response body as pretty-printed JSON.
 func (e HTTPError) bodyAsJSON() ([]byte, error) {
 	var jsonData any
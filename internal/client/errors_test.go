@@ -0,0 +1,49 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPErrorError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  HTTPError
+		want string
+	}{
+		{
+			name: "an error envelope",
+			err: HTTPError{StatusCode: 422, Body: []byte(`{"type": "validation_error", "status": 422, "request_id": "abc123",
+				"errors": [{"code": "is_required", "message": "Name is required", "source": {"field": "name"}},
+				{"code": "invalid", "message": "Something else is wrong"}]}`)},
+			want: "status 422 (validation_error): name: Name is required; Something else is wrong (request ID abc123)",
+		},
+		{
+			name: "an error with only a code",
+			err:  HTTPError{StatusCode: 404, Body: []byte(`{"type": "not_found", "errors": [{"code": "not_found"}]}`)},
+			want: "status 404 (not_found): not_found",
+		},
+		{
+			name: "JSON that isn't an envelope",
+			err:  HTTPError{StatusCode: 502, Body: []byte(`{"message":"bad gateway"}`)},
+			want: "status 502:\n\n{\n  \"message\": \"bad gateway\"\n}",
+		},
+		{
+			name: "not JSON",
+			err:  HTTPError{StatusCode: 502, Body: []byte(`Bad Gateway`)},
+			want: "status 502: Bad Gateway",
+		},
+		{
+			name: "no body",
+			err:  HTTPError{StatusCode: 500},
+			want: "status 500: empty response body",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.err.Error())
+		})
+	}
+}
@@ -0,0 +1,321 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// RecordingEnv switches a client to recording its requests to a cassette, when it's
+	// `record`, or to answering them from one without touching the network, when it's
+	// `replay`.
+	RecordingEnv = "INCIDENT_HTTP_RECORDING"
+	// CassetteEnv is the path of the cassette to record to or replay from.
+	CassetteEnv = "INCIDENT_HTTP_CASSETTE"
+	// RunIDEnv is the ID a recording test run names its resources with, which is saved
+	// with each cassette so that replaying it can name them the same. See CassetteRunID.
+	RunIDEnv = "INCIDENT_HTTP_RUN_ID"
+	// acceptanceTestEnv is set by `make testacc`. Recording only happens in acceptance
+	// tests, so a provider run for real never writes what the API sent it to disk, however
+	// its environment is set.
+	acceptanceTestEnv = "TF_ACC"
+)
+
+// redacted replaces the value of a header that mustn't be written to a cassette.
+const redacted = "REDACTED"
+
+// Cassette is a recording of requests to the API and their responses, saved as JSON so
+// it can be committed alongside the tests that made it.
+type Cassette struct {
+	path string
+
+	mu sync.Mutex
+	// Now is when the cassette was recorded, for a test to work out times in its config
+	// from, so that replaying it sends the same requests. See CassetteNow.
+	Now time.Time `json:"now"`
+	// RunID is the ID the test run that recorded the cassette named its resources with.
+	// See CassetteRunID.
+	RunID        string        `json:"run_id,omitempty"`
+	Interactions []Interaction `json:"interactions"`
+	// replayed marks each interaction that's been replayed, so a request made twice is
+	// answered by each recording of it in turn.
+	replayed []bool
+}
+
+// Interaction is one request and the response it got.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Body    string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Headers    http.Header `json:"headers,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassettes are the cassettes in use by this process, by mode and path, so that every
+// client a test builds records to, or replays from, the same one.
+var (
+	cassettesMu sync.Mutex
+	cassettes   = map[string]*Cassette{}
+)
+
+// recordingFromEnv returns a function that wraps a transport to record to or replay from
+// the cassette the environment names, or nil if recording is off.
+func recordingFromEnv() (func(http.RoundTripper) http.RoundTripper, error) {
+	cassette, mode, err := cassetteFromEnv()
+	if err != nil || cassette == nil {
+		return nil, err
+	}
+
+	if mode == "record" {
+		return cassette.Record, nil
+	}
+
+	return func(http.RoundTripper) http.RoundTripper {
+		return cassette.Replay()
+	}, nil
+}
+
+// CassetteNow is the time an acceptance test should work out the times in its config
+// from, such as when a maintenance window starts. While recording, it's the time the
+// cassette was started, which is saved with it; while replaying, it's that saved time, so
+// the test sends the requests it recorded. Otherwise it's the current time.
+func CassetteNow() (time.Time, error) {
+	cassette, mode, err := cassetteFromEnv()
+	if err != nil {
+		return time.Time{}, err
+	}
+	if cassette == nil {
+		return time.Now(), nil
+	}
+	if mode == "replay" && cassette.Now.IsZero() {
+		return time.Time{}, fmt.Errorf("cassette %s doesn't say when it was recorded: record it again with %s=record",
+			cassette.path, RecordingEnv)
+	}
+
+	return cassette.Now, nil
+}
+
+// CassetteRunID is the ID the test run that recorded the cassette named its resources
+// with. A replay must name them the same to send the requests it recorded, and each
+// recording picks its own, so it's only known once the cassette to replay is. While
+// recording, it's whatever RunIDEnv says; otherwise it's empty.
+func CassetteRunID() (string, error) {
+	cassette, mode, err := cassetteFromEnv()
+	if err != nil || cassette == nil {
+		return "", err
+	}
+	if mode == "replay" && cassette.RunID == "" {
+		return "", fmt.Errorf("cassette %s doesn't say which run recorded it: record it again with %s=record",
+			cassette.path, RecordingEnv)
+	}
+
+	return cassette.RunID, nil
+}
+
+// cassetteFromEnv returns the cassette the environment names, and whether to record or
+// replay it, or nil if recording is off.
+func cassetteFromEnv() (*Cassette, string, error) {
+	mode := os.Getenv(RecordingEnv)
+	if mode == "" || os.Getenv(acceptanceTestEnv) == "" {
+		return nil, "", nil
+	}
+	if mode != "record" && mode != "replay" {
+		return nil, "", fmt.Errorf("%s must be record or replay, got %q", RecordingEnv, mode)
+	}
+
+	path := os.Getenv(CassetteEnv)
+	if path == "" {
+		return nil, "", fmt.Errorf("%s is %s, so %s must name a cassette", RecordingEnv, mode, CassetteEnv)
+	}
+
+	cassettesMu.Lock()
+	defer cassettesMu.Unlock()
+
+	key := mode + ":" + path
+	cassette, ok := cassettes[key]
+	if !ok {
+		if mode == "record" {
+			cassette = NewCassette(path)
+			cassette.RunID = os.Getenv(RunIDEnv)
+		} else {
+			var err error
+			if cassette, err = LoadCassette(path); err != nil {
+				return nil, "", err
+			}
+		}
+		cassettes[key] = cassette
+	}
+
+	return cassette, mode, nil
+}
+
+// NewCassette starts an empty cassette, which replaces any at path once it records a
+// request.
+func NewCassette(path string) *Cassette {
+	return &Cassette{path: path, Now: time.Now().UTC().Truncate(time.Second), Interactions: []Interaction{}}
+}
+
+// LoadCassette reads a cassette to replay.
+func LoadCassette(path string) (*Cassette, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading cassette: %w", err)
+	}
+
+	cassette := &Cassette{path: path}
+	if err := json.Unmarshal(data, cassette); err != nil {
+		return nil, fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	cassette.replayed = make([]bool, len(cassette.Interactions))
+
+	return cassette, nil
+}
+
+// Record wraps a transport to save each request it sends and the response it gets to the
+// cassette, leaving out the API key.
+func (c *Cassette) Record(next http.RoundTripper) http.RoundTripper {
+	return Wrap(next, func(req *http.Request, next http.RoundTripper) (*http.Response, error) {
+		requestBody, err := readBody(&req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := next.RoundTrip(req)
+		if err != nil {
+			return nil, err
+		}
+
+		responseBody, err := readBody(&resp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		headers := req.Header.Clone()
+		if headers.Get("Authorization") != "" {
+			headers.Set("Authorization", redacted)
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		c.Interactions = append(c.Interactions, Interaction{
+			Request: RecordedRequest{
+				Method:  req.Method,
+				URL:     req.URL.String(),
+				Headers: headers,
+				Body:    requestBody,
+			},
+			Response: RecordedResponse{
+				StatusCode: resp.StatusCode,
+				Headers:    resp.Header,
+				Body:       responseBody,
+			},
+		})
+
+		// Save as we go, as nothing tells us when the last request has been made.
+		if err := c.save(); err != nil {
+			return nil, err
+		}
+
+		return resp, nil
+	})
+}
+
+// Replay returns a transport that answers each request with the first response recorded
+// for the same method, path, query and body that it hasn't already used, and fails any
+// request it has no recording for. It never sends a request, so works offline and
+// against any endpoint.
+func (c *Cassette) Replay() http.RoundTripper {
+	return Wrap(nil, func(req *http.Request, _ http.RoundTripper) (*http.Response, error) {
+		body, err := readBody(&req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		for idx, interaction := range c.Interactions {
+			if c.replayed[idx] || !matches(interaction.Request, req, body) {
+				continue
+			}
+			c.replayed[idx] = true
+
+			return &http.Response{
+				Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+				StatusCode:    interaction.Response.StatusCode,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        interaction.Response.Headers.Clone(),
+				Body:          io.NopCloser(bytes.NewBufferString(interaction.Response.Body)),
+				ContentLength: int64(len(interaction.Response.Body)),
+				Request:       req,
+			}, nil
+		}
+
+		return nil, fmt.Errorf("no recording of %s %s left in cassette %s: record it again with %s=record",
+			req.Method, req.URL.RequestURI(), c.path, RecordingEnv)
+	})
+}
+
+// matches is whether a recorded request is the same as req, ignoring the host so that a
+// cassette recorded against one endpoint replays against another.
+func matches(recorded RecordedRequest, req *http.Request, body string) bool {
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+
+	return recorded.Method == req.Method && recordedURL.RequestURI() == req.URL.RequestURI() && recorded.Body == body
+}
+
+func (c *Cassette) save() error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding cassette: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("creating cassette directory: %w", err)
+	}
+	if err := os.WriteFile(c.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+
+	return nil
+}
+
+// readBody reads a request or response body, putting back a copy so it can still be
+// read by whoever's next.
+func readBody(body *io.ReadCloser) (string, error) {
+	if *body == nil || *body == http.NoBody {
+		return "", nil
+	}
+
+	data, err := io.ReadAll(*body)
+	if err != nil {
+		return "", fmt.Errorf("reading body: %w", err)
+	}
+	_ = (*body).Close()
+	*body = io.NopCloser(bytes.NewReader(data))
+
+	return string(data), nil
+}
@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecording(t *testing.T) {
+	ctx := context.Background()
+	cassette := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/v1/severities/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"type": "not_found", "errors": [{"code": "not_found"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"severity": {"id": "01SEV", "name": "Minor", "description": "", "rank": 1, "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"}}`))
+	}))
+	defer server.Close()
+
+	t.Setenv("TF_ACC", "1")
+	t.Setenv(RecordingEnv, "record")
+	t.Setenv(CassetteEnv, cassette)
+	t.Setenv(RunIDEnv, "6f0c2a1e-recorded")
+
+	recorder, err := New(ctx, "secret-key", server.URL, "test")
+	if err != nil {
+		t.Fatalf("building the recording client: %v", err)
+	}
+	if _, err := recorder.SeveritiesV1ShowWithResponse(ctx, "01SEV"); err != nil {
+		t.Fatalf("recording a request: %v", err)
+	}
+	if _, err := recorder.SeveritiesV1ShowWithResponse(ctx, "missing"); err == nil {
+		t.Fatalf("expected the missing severity to fail")
+	}
+	assert.Equal(t, 2, requests)
+
+	recordedAt, err := CassetteNow()
+	if err != nil {
+		t.Fatalf("reading when the cassette was recorded: %v", err)
+	}
+	assert.WithinDuration(t, time.Now(), recordedAt, time.Minute)
+
+	t.Run("leaves out the API key", func(t *testing.T) {
+		data, err := os.ReadFile(cassette)
+		if err != nil {
+			t.Fatalf("reading the cassette: %v", err)
+		}
+
+		assert.NotContains(t, string(data), "secret-key")
+		assert.Contains(t, string(data), `"REDACTED"`)
+	})
+
+	t.Run("replays without sending requests", func(t *testing.T) {
+		t.Setenv(RecordingEnv, "replay")
+		server.Close()
+
+		replayer, err := New(ctx, "another-key", "https://api.example.com", "test")
+		if err != nil {
+			t.Fatalf("building the replaying client: %v", err)
+		}
+
+		result, err := replayer.SeveritiesV1ShowWithResponse(ctx, "01SEV")
+		if err != nil {
+			t.Fatalf("replaying a request: %v", err)
+		}
+		assert.Equal(t, "Minor", result.JSON200.Severity.Name)
+
+		_, err = replayer.SeveritiesV1ShowWithResponse(ctx, "missing")
+		assert.ErrorAs(t, err, &HTTPError{})
+
+		_, err = replayer.SeveritiesV1ShowWithResponse(ctx, "01SEV")
+		if assert.Error(t, err) {
+			assert.True(t, strings.HasPrefix(err.Error(), "Get \"https://api.example.com/v1/severities/01SEV\": no recording of GET /v1/severities/01SEV left"), err.Error())
+		}
+		assert.Equal(t, 2, requests)
+
+		now, err := CassetteNow()
+		if assert.NoError(t, err) {
+			assert.Equal(t, recordedAt, now)
+		}
+	})
+
+	t.Run("replays the run ID it was recorded with", func(t *testing.T) {
+		t.Setenv(RecordingEnv, "replay")
+		t.Setenv(RunIDEnv, "")
+
+		runID, err := CassetteRunID()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "6f0c2a1e-recorded", runID)
+		}
+	})
+
+	t.Run("fails to replay a cassette without a run ID", func(t *testing.T) {
+		t.Setenv(RecordingEnv, "replay")
+		unnamed := filepath.Join(t.TempDir(), "cassette.json")
+		t.Setenv(CassetteEnv, unnamed)
+		if err := os.WriteFile(unnamed, []byte(`{"now": "2025-01-01T00:00:00Z", "interactions": []}`), 0o644); err != nil {
+			t.Fatalf("writing the cassette: %v", err)
+		}
+
+		_, err := CassetteRunID()
+		assert.ErrorContains(t, err, "doesn't say which run recorded it")
+	})
+
+	t.Run("is off outside acceptance tests", func(t *testing.T) {
+		t.Setenv("TF_ACC", "")
+		t.Setenv(RecordingEnv, "record")
+		t.Setenv(CassetteEnv, filepath.Join(t.TempDir(), "cassette.json"))
+
+		live := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"severity": {"id": "01SEV", "name": "Minor", "description": "", "rank": 1, "created_at": "2025-01-01T00:00:00Z", "updated_at": "2025-01-01T00:00:00Z"}}`))
+		}))
+		defer live.Close()
+
+		c, err := New(ctx, "secret-key", live.URL, "test")
+		if err != nil {
+			t.Fatalf("building the client: %v", err)
+		}
+		if _, err := c.SeveritiesV1ShowWithResponse(ctx, "01SEV"); err != nil {
+			t.Fatalf("sending a request: %v", err)
+		}
+
+		_, err = os.Stat(os.Getenv(CassetteEnv))
+		assert.True(t, os.IsNotExist(err), "expected no cassette to be written")
+	})
+
+	t.Run("rejects an unknown mode", func(t *testing.T) {
+		t.Setenv(RecordingEnv, "rewind")
+
+		_, err := New(ctx, "secret-key", server.URL, "test")
+		assert.ErrorContains(t, err, "INCIDENT_HTTP_RECORDING must be record or replay")
+	})
+}
@@ -0,0 +1,326 @@
+// Package export writes an organisation's existing configuration out as Terraform, so
+// that what was set up in the dashboard can be brought under Terraform's management.
+package export
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/pkg/errors"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider"
+)
+
+// Exporter reads every resource the provider can list, and writes each out as a
+// resource block with an import block to adopt it.
+//
+// Resources are read through the provider itself, exactly as `terraform import` reads
+// them, so the configuration written is built by the same conversions Terraform
+// compares it against, and planning it should show no changes.
+type Exporter struct {
+	client *client.ClientWithResponses
+	// Types limits the export to these resource types, or every type that can be listed
+	// when empty.
+	Types []string
+}
+
+func New(apiClient *client.ClientWithResponses) *Exporter {
+	return &Exporter{client: apiClient}
+}
+
+// Result is the Terraform an export wrote, by file name.
+type Result struct {
+	Files map[string][]byte
+	// Skipped says why each resource that couldn't be exported was left out.
+	Skipped []string
+}
+
+// exportedResource is a resource as read from the API, and the name it's exported as.
+type exportedResource struct {
+	typeName string
+	name     string
+	id       string
+	schema   schema.Schema
+	value    tftypes.Value
+	// skipped says why the resource isn't exported, if it isn't.
+	skipped string
+}
+
+// exportedCatalogEntry is a catalog entry referenced by an exported resource, which is
+// looked up by its external ID rather than managed.
+type exportedCatalogEntry struct {
+	name          string
+	catalogTypeID string
+	externalID    string
+}
+
+// skipResource says why a resource shouldn't be exported, if it shouldn't be.
+var skipResource = map[string]func(ctx context.Context, state tfsdk.Resource) (string, diag.Diagnostics){
+	"incident_catalog_type": func(ctx context.Context, state tfsdk.Resource) (string, diag.Diagnostics) {
+		var sourceRepoURL *string
+		diags := state.GetAttribute(ctx, path.Root("source_repo_url"), &sourceRepoURL)
+		if sourceRepoURL != nil {
+			return fmt.Sprintf("it's managed by the catalog importer in %s", *sourceRepoURL), diags
+		}
+
+		return "", diags
+	},
+}
+
+func (e *Exporter) Export(ctx context.Context) (*Result, error) {
+	p := provider.New("export")().(*provider.IncidentProvider)
+
+	resourceTypes := map[string]resource.Resource{}
+	for _, newResource := range p.Resources(ctx) {
+		res := newResource()
+		resourceTypes[typeName(ctx, res)] = res
+	}
+
+	result := &Result{Files: map[string][]byte{}}
+	resources := []*exportedResource{}
+	for _, newListResource := range p.ListResources(ctx) {
+		listResource := newListResource()
+		name := typeName(ctx, listResource)
+		if len(e.Types) > 0 && !slices.Contains(e.Types, name) {
+			continue
+		}
+
+		listed, err := e.list(ctx, listResource, resourceTypes[name])
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("listing %s resources", name))
+		}
+		for _, res := range listed {
+			if res.skipped != "" {
+				result.Skipped = append(result.Skipped, fmt.Sprintf("%s %s (%s): %s", res.typeName, res.id, res.name, res.skipped))
+				continue
+			}
+			resources = append(resources, res)
+		}
+	}
+
+	names := newNamer()
+	for _, res := range resources {
+		res.name = names.name(res.typeName, res.name)
+	}
+
+	entries, err := e.catalogEntries(ctx, resources, names)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Files = render(resources, entries)
+
+	return result, nil
+}
+
+// list reads every resource of one type, as `terraform query` would with
+// `include_resource`, including those that can't be read or shouldn't be exported, with
+// the reason why.
+func (e *Exporter) list(ctx context.Context, listResource list.ListResource, res resource.Resource) ([]*exportedResource, error) {
+	var configureResp resource.ConfigureResponse
+	listResource.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: &provider.IncidentProviderData{Client: e.client},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		return nil, diagnosticsError(configureResp.Diagnostics)
+	}
+
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	res.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+	var configSchemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+
+	stream := list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResp.Schema,
+			Raw:    tftypes.NewValue(configSchemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{}),
+		},
+		IncludeResource:        true,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}, &stream)
+
+	typeName := typeName(ctx, res)
+	resources := []*exportedResource{}
+	for item := range stream.Results {
+		if item.Identity == nil {
+			// Only a failed list has no identity.
+			return nil, diagnosticsError(item.Diagnostics)
+		}
+
+		var id string
+		item.Identity.GetAttribute(ctx, path.Root("id"), &id)
+
+		exported := &exportedResource{
+			typeName: typeName,
+			name:     item.DisplayName,
+			id:       id,
+			schema:   schemaResp.Schema,
+			value:    item.Resource.Raw,
+		}
+		if item.Diagnostics.HasError() {
+			exported.skipped = fmt.Sprintf("it couldn't be read: %s", diagnosticsError(item.Diagnostics))
+		} else if skip, ok := skipResource[typeName]; ok {
+			reason, diags := skip(ctx, *item.Resource)
+			if diags.HasError() {
+				return nil, errors.Wrap(diagnosticsError(diags), fmt.Sprintf("checking whether to export %s %s", typeName, id))
+			}
+			exported.skipped = reason
+		}
+
+		resources = append(resources, exported)
+	}
+
+	return resources, nil
+}
+
+// ulid matches an incident.io ID.
+var ulid = regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{26}$`)
+
+// catalogEntries looks up the catalog entries that exported resources refer to by ID,
+// such as in an alert route's conditions, so they can be referred to by their external
+// ID instead, which stays the same when an entry is recreated by a catalog sync. Any ID
+// that isn't another exported resource is looked for, and those that aren't catalog
+// entries, or whose entry has no external ID, are left as they are.
+//
+// An ID doesn't say which catalog type it's from, so this lists each type's entries in
+// turn, once, until every ID has been found or there are no types left. That's as many
+// requests as it takes to list the catalog, however many IDs the resources refer to.
+func (e *Exporter) catalogEntries(ctx context.Context, resources []*exportedResource, names *namer) (map[string]exportedCatalogEntry, error) {
+	exportedIDs := map[string]bool{}
+	for _, res := range resources {
+		exportedIDs[res.id] = true
+	}
+
+	wanted := map[string]bool{}
+	for _, res := range resources {
+		for _, value := range stringValues(res.value) {
+			if !exportedIDs[value] && ulid.MatchString(value) {
+				wanted[value] = true
+			}
+		}
+	}
+
+	entries := map[string]exportedCatalogEntry{}
+	if len(wanted) == 0 {
+		return entries, nil
+	}
+
+	typesResult, err := e.client.CatalogV3ListTypesWithResponse(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "listing catalog types")
+	}
+
+	for _, catalogType := range typesResult.JSON200.CatalogTypes {
+		typeEntries, err := e.listCatalogEntries(ctx, catalogType.Id)
+		if err != nil {
+			return nil, errors.Wrap(err, fmt.Sprintf("listing %s catalog entries", catalogType.TypeName))
+		}
+
+		for _, entry := range typeEntries {
+			if !wanted[entry.Id] {
+				continue
+			}
+			delete(wanted, entry.Id)
+
+			if entry.ExternalId == nil || *entry.ExternalId == "" {
+				continue
+			}
+			entries[entry.Id] = exportedCatalogEntry{
+				name:          names.name("data.incident_catalog_entry", catalogType.TypeName+"_"+*entry.ExternalId),
+				catalogTypeID: entry.CatalogTypeId,
+				externalID:    *entry.ExternalId,
+			}
+		}
+
+		if len(wanted) == 0 {
+			break
+		}
+	}
+
+	return entries, nil
+}
+
+// listCatalogEntries returns every entry of a catalog type, following the pagination
+// cursor until the last page. A type that's been deleted since it was listed has none.
+func (e *Exporter) listCatalogEntries(ctx context.Context, catalogTypeID string) ([]client.CatalogEntryV3, error) {
+	entries := []client.CatalogEntryV3{}
+	params := &client.CatalogV3ListEntriesParams{
+		CatalogTypeId: catalogTypeID,
+		PageSize:      250,
+	}
+
+	for {
+		result, err := e.client.CatalogV3ListEntriesWithResponse(ctx, params)
+		if isNotFound(err) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, result.JSON200.CatalogEntries...)
+
+		if result.JSON200.PaginationMeta.After == nil {
+			return entries, nil
+		}
+		params.After = result.JSON200.PaginationMeta.After
+	}
+}
+
+// stringValues is every string within a value, such as the IDs it refers to.
+func stringValues(value tftypes.Value) []string {
+	values := []string{}
+	_ = tftypes.Walk(value, func(_ *tftypes.AttributePath, value tftypes.Value) (bool, error) {
+		var str string
+		if value.Type().Is(tftypes.String) && value.IsKnown() && !value.IsNull() && value.As(&str) == nil {
+			values = append(values, str)
+		}
+
+		return true, nil
+	})
+
+	return values
+}
+
+// typeName is the type of a resource, or of the resources a list resource lists.
+func typeName(ctx context.Context, res interface {
+	Metadata(context.Context, resource.MetadataRequest, *resource.MetadataResponse)
+}) string {
+	var metadata resource.MetadataResponse
+	res.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "incident"}, &metadata)
+
+	return metadata.TypeName
+}
+
+func diagnosticsError(diags diag.Diagnostics) error {
+	messages := []string{}
+	for _, d := range diags.Errors() {
+		messages = append(messages, fmt.Sprintf("%s: %s", d.Summary(), d.Detail()))
+	}
+
+	return errors.New(strings.Join(messages, "; "))
+}
+
+// isNotFound reports whether err is a 404 from the API. Any other error, such as a
+// rejected API key or a rate limit that outlasted the client's retries, would leave the
+// export incomplete, so is returned rather than skipped.
+func isNotFound(err error) bool {
+	httpErr := client.HTTPError{}
+	return errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,296 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// fakeAPI serves canned JSON by route, returning a 404 for any other.
+type fakeAPI map[string]func(r *http.Request) string
+
+func (f fakeAPI) start(t *testing.T) *client.ClientWithResponses {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for pattern, body := range f {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body(r)))
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	api, err := client.New(t.Context(), "test-key", server.URL, "test", client.WithReadOnly())
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	return api
+}
+
+const testSeverityJSON = `{"id": "01SEV%[1]d", "name": "Sev %[1]d", "description": "Sev %[1]d ${thing}", "rank": %[1]d,
+	"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}`
+
+func TestExport(t *testing.T) {
+	api := fakeAPI{
+		"GET /v1/severities": func(r *http.Request) string {
+			return `{"severities": [` + fmt.Sprintf(testSeverityJSON, 1) + `, ` + fmt.Sprintf(testSeverityJSON, 2) + `]}`
+		},
+		"GET /v1/severities/{id}": func(r *http.Request) string {
+			var rank int
+			_, _ = fmt.Sscanf(r.PathValue("id"), "01SEV%d", &rank)
+			return fmt.Sprintf(`{"severity": `+testSeverityJSON+`}`, rank)
+		},
+	}.start(t)
+
+	exporter := New(api)
+	exporter.Types = []string{"incident_severity"}
+
+	result, err := exporter.Export(context.Background())
+	if err != nil {
+		t.Fatalf("exporting: %v", err)
+	}
+
+	assert.Empty(t, result.Skipped)
+	assert.Equal(t, `import {
+  to = incident_severity.sev_1
+  id = "01SEV1"
+}
+
+resource "incident_severity" "sev_1" {
+  description = "Sev 1 $${thing}"
+  name        = "Sev 1"
+  rank        = 1
+}
+
+import {
+  to = incident_severity.sev_2
+  id = "01SEV2"
+}
+
+resource "incident_severity" "sev_2" {
+  description = "Sev 2 $${thing}"
+  name        = "Sev 2"
+  rank        = 2
+}
+`, string(result.Files["incident_severity.tf"]))
+}
+
+// testSchema has an attribute of every kind the writer treats differently.
+var testSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"name": schema.StringAttribute{Required: true},
+		"old_name": schema.StringAttribute{
+			Optional:           true,
+			DeprecationMessage: "Use name instead.",
+		},
+		"escalation_path_id": schema.StringAttribute{Optional: true},
+		"targets": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id":   schema.StringAttribute{Required: true},
+					"type": schema.StringAttribute{Optional: true},
+					"urn":  schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	},
+	Blocks: map[string]schema.Block{
+		"condition": schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"values": schema.SetAttribute{ElementType: types.StringType, Optional: true},
+				},
+			},
+		},
+	},
+}
+
+func testValue(t *testing.T, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	typ := testSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	for name, attrType := range typ.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	return tftypes.NewValue(typ, values)
+}
+
+func TestRender(t *testing.T) {
+	typ := testSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	targetType := typ.AttributeTypes["targets"].(tftypes.List).ElementType
+	conditionType := typ.AttributeTypes["condition"].(tftypes.List).ElementType
+
+	escalationPath := &exportedResource{
+		typeName: "incident_escalation_path",
+		name:     "urgent",
+		id:       "01PATH",
+		schema:   testSchema,
+		value: testValue(t, map[string]tftypes.Value{
+			"id":   tftypes.NewValue(tftypes.String, "01PATH"),
+			"name": tftypes.NewValue(tftypes.String, "Urgent"),
+		}),
+	}
+	route := &exportedResource{
+		typeName: "incident_alert_route",
+		name:     "payments",
+		id:       "01ROUTE",
+		schema:   testSchema,
+		value: testValue(t, map[string]tftypes.Value{
+			"id":                 tftypes.NewValue(tftypes.String, "01ROUTE"),
+			"name":               tftypes.NewValue(tftypes.String, "Payments"),
+			"old_name":           tftypes.NewValue(tftypes.String, "Payments"),
+			"escalation_path_id": tftypes.NewValue(tftypes.String, "01PATH"),
+			"targets": tftypes.NewValue(typ.AttributeTypes["targets"], []tftypes.Value{
+				tftypes.NewValue(targetType, map[string]tftypes.Value{
+					"id":   tftypes.NewValue(tftypes.String, "01ROUTE"),
+					"type": tftypes.NewValue(tftypes.String, nil),
+					"urn":  tftypes.NewValue(tftypes.String, "urn:01ROUTE"),
+				}),
+			}),
+			"condition": tftypes.NewValue(typ.AttributeTypes["condition"], []tftypes.Value{
+				tftypes.NewValue(conditionType, map[string]tftypes.Value{
+					"values": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+						tftypes.NewValue(tftypes.String, "01ENTRY"),
+						tftypes.NewValue(tftypes.String, "01OTHER"),
+					}),
+				}),
+			}),
+		}),
+	}
+
+	files := render([]*exportedResource{escalationPath, route}, map[string]exportedCatalogEntry{
+		"01ENTRY": {name: "service_payments", catalogTypeID: "01TYPE", externalID: "payments"},
+	})
+
+	assert.Equal(t, `import {
+  to = incident_alert_route.payments
+  id = "01ROUTE"
+}
+
+resource "incident_alert_route" "payments" {
+  escalation_path_id = incident_escalation_path.urgent.id
+  name               = "Payments"
+  targets = [{
+    id = "01ROUTE"
+  }]
+  condition {
+    values = [data.incident_catalog_entry.service_payments.id, "01OTHER"]
+  }
+}
+`, string(files["incident_alert_route.tf"]))
+
+	assert.Equal(t, `data "incident_catalog_entry" "service_payments" {
+  catalog_type_id = "01TYPE"
+  identifier      = "payments"
+}
+`, string(files[catalogEntriesFile]))
+}
+
+func TestCatalogEntries(t *testing.T) {
+	const entryID, noExternalID, otherID = "01HZ0000000000000000000001", "01HZ0000000000000000000002", "01HZ0000000000000000000003"
+	entry := func(id, externalID string) string {
+		return `{"id": "` + id + `", "catalog_type_id": "01SERVICE", "external_id": "` + externalID + `",
+			"name": "Payments", "aliases": [], "attribute_values": {}, "rank": 0,
+			"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}`
+	}
+
+	requests := 0
+	api := fakeAPI{
+		"GET /v3/catalog_types": func(r *http.Request) string {
+			requests++
+			return `{"catalog_types": [{"id": "01TEAM", "type_name": "Team"}, {"id": "01SERVICE", "type_name": "PagerDutyService"}, {"id": "01UNUSED", "type_name": "Unused"}]}`
+		},
+		"GET /v3/catalog_entries": func(r *http.Request) string {
+			requests++
+			switch r.URL.Query().Get("catalog_type_id") + "/" + r.URL.Query().Get("after") {
+			case "01SERVICE/":
+				return `{"catalog_entries": [` + entry(noExternalID, "") + `], "catalog_type": {"id": "01SERVICE"}, "pagination_meta": {"page_size": 250, "after": "` + noExternalID + `"}}`
+			case "01SERVICE/" + noExternalID:
+				return `{"catalog_entries": [` + entry(entryID, "P123") + `], "catalog_type": {"id": "01SERVICE"}, "pagination_meta": {"page_size": 250}}`
+			default:
+				return `{"catalog_entries": [], "catalog_type": {"id": "01TEAM"}, "pagination_meta": {"page_size": 250}}`
+			}
+		},
+	}.start(t)
+
+	resources := []*exportedResource{{
+		typeName: "incident_alert_route",
+		id:       "01ROUTE",
+		value: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, entryID),
+			tftypes.NewValue(tftypes.String, noExternalID),
+			tftypes.NewValue(tftypes.String, "not an ID"),
+		}),
+	}}
+
+	entries, err := New(api).catalogEntries(context.Background(), resources, newNamer())
+
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]exportedCatalogEntry{
+		entryID: {name: "pagerdutyservice_p123", catalogTypeID: "01SERVICE", externalID: "P123"},
+	}, entries)
+	assert.Equal(t, 4, requests, "expected to stop listing once every ID was found")
+
+	t.Run("lists each type once, however many IDs there are", func(t *testing.T) {
+		requests = 0
+		resources[0].value = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, entryID),
+			tftypes.NewValue(tftypes.String, otherID),
+			tftypes.NewValue(tftypes.String, "01HZ0000000000000000000004"),
+			tftypes.NewValue(tftypes.String, "01HZ0000000000000000000005"),
+		})
+
+		_, err := New(api).catalogEntries(context.Background(), resources, newNamer())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 5, requests)
+	})
+
+	t.Run("fails when the API key is rejected", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"type": "authentication_error", "status": 401, "errors": [{"code": "authentication_error", "message": "Invalid API key"}]}`))
+		}))
+		t.Cleanup(server.Close)
+		rejected, err := client.New(t.Context(), "bad-key", server.URL, "test")
+		if err != nil {
+			t.Fatalf("building client: %v", err)
+		}
+
+		_, err = New(rejected).catalogEntries(context.Background(), resources, newNamer())
+
+		httpErr := client.HTTPError{}
+		if assert.ErrorAs(t, err, &httpErr) {
+			assert.Equal(t, http.StatusUnauthorized, httpErr.StatusCode)
+		}
+	})
+}
+
+func TestNamer(t *testing.T) {
+	names := newNamer()
+
+	assert.Equal(t, "payments_team", names.name("incident_escalation_path", "Payments team!"))
+	assert.Equal(t, "payments_team_2", names.name("incident_escalation_path", "payments-team"))
+	assert.Equal(t, "payments_team", names.name("incident_schedule", "Payments team"))
+	assert.Equal(t, "_24_7", names.name("incident_schedule", "24/7"))
+	assert.Equal(t, "unnamed", names.name("incident_schedule", "🚨"))
+}
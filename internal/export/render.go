@@ -0,0 +1,305 @@
+package export
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// catalogEntriesFile is the file the catalog entries that resources refer to are looked
+// up in.
+const catalogEntriesFile = "catalog_entries.tf"
+
+// render writes each type of resource to its own file, as a resource block for each
+// resource and an import block to adopt it. Where a resource refers to another by ID,
+// such as an alert route to its escalation path, it refers to the other's block instead.
+func render(resources []*exportedResource, entries map[string]exportedCatalogEntry) map[string][]byte {
+	references := map[string]hcl.Traversal{}
+	for _, res := range resources {
+		references[res.id] = traversal(res.typeName, res.name, "id")
+	}
+	for id, entry := range entries {
+		references[id] = traversal("data", "incident_catalog_entry", entry.name, "id")
+	}
+
+	files := map[string]*hclwrite.File{}
+	for _, res := range resources {
+		fileName := res.typeName + ".tf"
+		file, ok := files[fileName]
+		if !ok {
+			file = hclwrite.NewEmptyFile()
+			files[fileName] = file
+		}
+
+		w := &writer{references: references, self: res.id}
+		body := file.Body()
+		if len(body.Blocks()) > 0 {
+			body.AppendNewline()
+		}
+
+		importBlock := body.AppendNewBlock("import", nil)
+		importBlock.Body().SetAttributeTraversal("to", traversal(res.typeName, res.name))
+		importBlock.Body().SetAttributeValue("id", cty.StringVal(res.id))
+		body.AppendNewline()
+
+		block := body.AppendNewBlock("resource", []string{res.typeName, res.name})
+		w.writeObject(block.Body(), res.schema.Attributes, res.schema.Blocks, res.value)
+	}
+
+	if len(entries) > 0 {
+		file := hclwrite.NewEmptyFile()
+		files[catalogEntriesFile] = file
+
+		ids := []string{}
+		for id := range entries {
+			ids = append(ids, id)
+		}
+		sort.Slice(ids, func(i, j int) bool { return entries[ids[i]].name < entries[ids[j]].name })
+
+		w := &writer{references: references}
+		for idx, id := range ids {
+			if idx > 0 {
+				file.Body().AppendNewline()
+			}
+
+			entry := entries[id]
+			body := file.Body().AppendNewBlock("data", []string{"incident_catalog_entry", entry.name}).Body()
+			body.SetAttributeRaw("catalog_type_id", w.stringTokens(entry.catalogTypeID))
+			body.SetAttributeValue("identifier", cty.StringVal(entry.externalID))
+		}
+	}
+
+	rendered := map[string][]byte{}
+	for fileName, file := range files {
+		rendered[fileName] = hclwrite.Format(file.Bytes())
+	}
+
+	return rendered
+}
+
+// writer writes a resource's value as configuration, referring to other resources it
+// has the ID of.
+type writer struct {
+	references map[string]hcl.Traversal
+	// self is the ID of the resource being written, which it can't refer to.
+	self string
+}
+
+// configurable reports whether an attribute is written. Attributes that are only ever
+// computed can't be set, and deprecated ones are left out in favour of whatever replaced
+// them.
+func configurable(attr schema.Attribute) bool {
+	return (attr.IsRequired() || attr.IsOptional()) && !attr.IsWriteOnly() && attr.GetDeprecationMessage() == ""
+}
+
+// writeObject writes the attributes of an object that can be configured, and its nested
+// blocks.
+func (w *writer) writeObject(body *hclwrite.Body, attributes map[string]schema.Attribute, blocks map[string]schema.Block, value tftypes.Value) {
+	values := map[string]tftypes.Value{}
+	_ = value.As(&values)
+
+	for _, name := range sortedKeys(attributes) {
+		attr := attributes[name]
+		if !configurable(attr) || values[name].IsNull() {
+			continue
+		}
+
+		body.SetAttributeRaw(name, w.attributeTokens(attr, values[name]))
+	}
+
+	for _, name := range sortedKeys(blocks) {
+		if values[name].IsNull() {
+			continue
+		}
+
+		switch block := blocks[name].(type) {
+		case schema.SingleNestedBlock:
+			w.writeObject(body.AppendNewBlock(name, nil).Body(), block.Attributes, block.Blocks, values[name])
+		case schema.ListNestedBlock:
+			for _, elem := range elements(values[name]) {
+				w.writeObject(body.AppendNewBlock(name, nil).Body(), block.NestedObject.Attributes, block.NestedObject.Blocks, elem)
+			}
+		case schema.SetNestedBlock:
+			for _, elem := range elements(values[name]) {
+				w.writeObject(body.AppendNewBlock(name, nil).Body(), block.NestedObject.Attributes, block.NestedObject.Blocks, elem)
+			}
+		}
+	}
+}
+
+// attributeTokens is an attribute's value, where the objects in a nested attribute are
+// written as a nested block's would be.
+func (w *writer) attributeTokens(attr schema.Attribute, value tftypes.Value) hclwrite.Tokens {
+	switch attr := attr.(type) {
+	case schema.SingleNestedAttribute:
+		return w.objectTokens(attr.Attributes, value)
+	case schema.ListNestedAttribute:
+		return w.tupleTokens(attr.NestedObject.Attributes, value)
+	case schema.SetNestedAttribute:
+		return w.tupleTokens(attr.NestedObject.Attributes, value)
+	case schema.MapNestedAttribute:
+		values := map[string]tftypes.Value{}
+		_ = value.As(&values)
+
+		attrs := []hclwrite.ObjectAttrTokens{}
+		for _, key := range sortedKeys(values) {
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{
+				Name:  hclwrite.TokensForValue(cty.StringVal(key)),
+				Value: w.objectTokens(attr.NestedObject.Attributes, values[key]),
+			})
+		}
+
+		return hclwrite.TokensForObject(attrs)
+	}
+
+	return w.valueTokens(value)
+}
+
+func (w *writer) objectTokens(attributes map[string]schema.Attribute, value tftypes.Value) hclwrite.Tokens {
+	values := map[string]tftypes.Value{}
+	_ = value.As(&values)
+
+	attrs := []hclwrite.ObjectAttrTokens{}
+	for _, name := range sortedKeys(attributes) {
+		attr := attributes[name]
+		if !configurable(attr) || values[name].IsNull() {
+			continue
+		}
+
+		attrs = append(attrs, hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(name),
+			Value: w.attributeTokens(attr, values[name]),
+		})
+	}
+
+	return hclwrite.TokensForObject(attrs)
+}
+
+func (w *writer) tupleTokens(attributes map[string]schema.Attribute, value tftypes.Value) hclwrite.Tokens {
+	elems := []hclwrite.Tokens{}
+	for _, elem := range elements(value) {
+		elems = append(elems, w.objectTokens(attributes, elem))
+	}
+
+	return hclwrite.TokensForTuple(elems)
+}
+
+// valueTokens is a value of no particular schema, such as a list of strings.
+func (w *writer) valueTokens(value tftypes.Value) hclwrite.Tokens {
+	if value.IsNull() {
+		return hclwrite.TokensForValue(cty.NullVal(cty.DynamicPseudoType))
+	}
+
+	switch typ := value.Type().(type) {
+	case tftypes.Map, tftypes.Object:
+		values := map[string]tftypes.Value{}
+		_ = value.As(&values)
+
+		attrs := []hclwrite.ObjectAttrTokens{}
+		for _, key := range sortedKeys(values) {
+			name := hclwrite.TokensForIdentifier(key)
+			if _, ok := typ.(tftypes.Map); ok {
+				name = hclwrite.TokensForValue(cty.StringVal(key))
+			}
+			attrs = append(attrs, hclwrite.ObjectAttrTokens{Name: name, Value: w.valueTokens(values[key])})
+		}
+
+		return hclwrite.TokensForObject(attrs)
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		elems := []hclwrite.Tokens{}
+		for _, elem := range elements(value) {
+			elems = append(elems, w.valueTokens(elem))
+		}
+
+		return hclwrite.TokensForTuple(elems)
+	}
+
+	switch {
+	case value.Type().Is(tftypes.Number):
+		number := new(big.Float)
+		_ = value.As(number)
+		return hclwrite.TokensForValue(cty.NumberVal(number))
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return hclwrite.TokensForValue(cty.BoolVal(b))
+	default:
+		var str string
+		_ = value.As(&str)
+		return w.stringTokens(str)
+	}
+}
+
+// stringTokens is a string, or a reference to the resource it's the ID of.
+func (w *writer) stringTokens(str string) hclwrite.Tokens {
+	if reference, ok := w.references[str]; ok && str != w.self {
+		return hclwrite.TokensForTraversal(reference)
+	}
+
+	return hclwrite.TokensForValue(cty.StringVal(str))
+}
+
+// elements is the elements of a list, set or tuple.
+func elements(value tftypes.Value) []tftypes.Value {
+	elems := []tftypes.Value{}
+	_ = value.As(&elems)
+
+	return elems
+}
+
+func traversal(names ...string) hcl.Traversal {
+	traversal := hcl.Traversal{hcl.TraverseRoot{Name: names[0]}}
+	for _, name := range names[1:] {
+		traversal = append(traversal, hcl.TraverseAttr{Name: name})
+	}
+
+	return traversal
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// namer names resources after what they're called in the dashboard, in a form Terraform
+// accepts, and unique among those of the same type.
+type namer struct {
+	used map[string]bool
+}
+
+func newNamer() *namer {
+	return &namer{used: map[string]bool{}}
+}
+
+var nonIdentifier = regexp.MustCompile(`[^a-z0-9]+`)
+
+func (n *namer) name(typeName, displayName string) string {
+	name := strings.Trim(nonIdentifier.ReplaceAllString(strings.ToLower(displayName), "_"), "_")
+	if name == "" {
+		name = "unnamed"
+	}
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+
+	unique := name
+	for idx := 2; n.used[typeName+"."+unique]; idx++ {
+		unique = fmt.Sprintf("%s_%d", name, idx)
+	}
+	n.used[typeName+"."+unique] = true
+
+	return unique
+}
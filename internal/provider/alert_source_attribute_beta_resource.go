@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                   = &alertSourceAttributeBetaResource{}
 	_ resource.ResourceWithConfigure      = &alertSourceAttributeBetaResource{}
 	_ resource.ResourceWithImportState    = &alertSourceAttributeBetaResource{}
+	_ resource.ResourceWithIdentity       = &alertSourceAttributeBetaResource{}
 	_ resource.ResourceWithValidateConfig = &alertSourceAttributeBetaResource{}
 	_ resource.ResourceWithModifyPlan     = &alertSourceAttributeBetaResource{}
 )
@@ -60,13 +62,14 @@ type alertSourceAttributeBetaModel struct {
 
 	Expression       *models.Expression       `tfsdk:"expression"`
 	NamedExpressions []models.NamedExpression `tfsdk:"named_expression"`
+	Timeouts         timeouts.Value           `tfsdk:"timeouts"`
 }
 
 func (r *alertSourceAttributeBetaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_source_attribute_beta"
 }
 
-func (r *alertSourceAttributeBetaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *alertSourceAttributeBetaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	attributes := map[string]schema.Attribute{
 		"alert_source_id": schema.StringAttribute{
 			Required:            true,
@@ -122,6 +125,7 @@ compatible, so pin the provider version if that matters to you.
 `+"`incident_alert_source`"+` is not deprecated, and there is no need to move anything yet.`),
 		Attributes: attributes,
 		Blocks: map[string]schema.Block{
+			"timeouts":         timeoutsBlock(ctx),
 			"expression":       models.ExpressionBlock(),
 			"named_expression": models.NamedExpressionBlock(),
 		},
@@ -318,6 +322,9 @@ func (r *alertSourceAttributeBetaResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	binding := r.toPayload(&data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -342,22 +349,22 @@ func (r *alertSourceAttributeBetaResource) Create(ctx context.Context, req resou
 	})
 	if isConflict(err) {
 		attributeID := data.AlertAttributeID.ValueString()
-		summary, detail := alertSourceAttributeConflict(
+		addAlertSourceAttributeConflict(ctx, &resp.Diagnostics, resp.State.Schema,
 			r.attributeIsBound(ctx, sourceID, attributeID), sourceID, attributeID, err)
-		resp.Diagnostics.AddError(summary, detail)
 
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to bind alert attribute", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to bind alert attribute", err)
 		return
 	}
 	if result.JSON201 == nil {
-		resp.Diagnostics.AddError("Unable to bind alert attribute", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to bind alert attribute", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceAttributeBetaFromAPI(result.JSON201.AlertSourceAttribute, &data))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "alert_source_id", "alert_attribute_id")
 }
 
 func (r *alertSourceAttributeBetaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -367,6 +374,9 @@ func (r *alertSourceAttributeBetaResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.AlertSourcesV3ShowAttributeWithResponse(
 		ctx, data.AlertSourceID.ValueString(), data.AlertAttributeID.ValueString())
 	// Covers the source going away as well as the binding: either way this resource is gone.
@@ -375,18 +385,19 @@ func (r *alertSourceAttributeBetaResource) Read(ctx context.Context, req resourc
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read alert source attribute", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert source attribute", err)
 		return
 	}
 	// The client turns any non-2xx into an error, so a missing body is an unexpected success
 	// rather than an unbound attribute. Dropping it from state would have the next apply try to
 	// bind it again and hit the 409, so fail instead.
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to read alert source attribute", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert source attribute", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceAttributeBetaFromAPI(result.JSON200.AlertSourceAttribute, &data))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "alert_source_id", "alert_attribute_id")
 }
 
 func (r *alertSourceAttributeBetaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -396,6 +407,9 @@ func (r *alertSourceAttributeBetaResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	binding := r.toPayload(&plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -419,19 +433,20 @@ func (r *alertSourceAttributeBetaResource) Update(ctx context.Context, req resou
 		)
 	})
 	if isConflict(err) {
-		resp.Diagnostics.AddError("Unable to update alert source attribute", alertSourceAttributeContended(err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", alertSourceAttributeContended("Unable to update alert source attribute"), err)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update alert source attribute", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert source attribute", err)
 		return
 	}
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to update alert source attribute", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert source attribute", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceAttributeBetaFromAPI(result.JSON200.AlertSourceAttribute, &plan))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "alert_source_id", "alert_attribute_id")
 }
 
 func (r *alertSourceAttributeBetaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -441,6 +456,9 @@ func (r *alertSourceAttributeBetaResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	sourceID := data.AlertSourceID.ValueString()
 
 	_, err := lockForAlertSource(ctx, sourceID, func(ctx context.Context) (*client.AlertSourcesV3DestroyAttributeResponse, error) {
@@ -452,20 +470,21 @@ func (r *alertSourceAttributeBetaResource) Delete(ctx context.Context, req resou
 		)
 	})
 	if isConflict(err) {
-		resp.Diagnostics.AddError("Unable to unbind alert attribute", alertSourceAttributeContended(err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", alertSourceAttributeContended("Unable to unbind alert attribute"), err)
 		return
 	}
 	if err != nil && !isNotFound(err) {
-		resp.Diagnostics.AddError("Unable to unbind alert attribute", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to unbind alert attribute", err)
 	}
 }
 
 func (r *alertSourceAttributeBetaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	sourceID, attributeID, found := strings.Cut(req.ID, alertSourceAttributeImportSeparator)
+	id := importID(ctx, req, alertSourceAttributeImportSeparator, &resp.Diagnostics, "alert_source_id", "alert_attribute_id")
+	sourceID, attributeID, found := strings.Cut(id, alertSourceAttributeImportSeparator)
 	if !found || sourceID == "" || attributeID == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import identifier",
-			fmt.Sprintf("expected <alert_source_id>%s<alert_attribute_id>, got %q.", alertSourceAttributeImportSeparator, req.ID),
+			fmt.Sprintf("expected <alert_source_id>%s<alert_attribute_id>, got %q.", alertSourceAttributeImportSeparator, id),
 		)
 		return
 	}
@@ -476,6 +495,13 @@ func (r *alertSourceAttributeBetaResource) ImportState(ctx context.Context, req
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("alert_attribute_id"), attributeID)...)
 }
 
+func (r *alertSourceAttributeBetaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"alert_source_id":    "The ID of the alert source the attribute is bound on.",
+		"alert_attribute_id": "The ID of the alert attribute that's bound.",
+	})
+}
+
 const alertSourceAttributeImportSeparator = ":"
 
 func alertSourceAttributeImportID(sourceID, attributeID string) string {
@@ -580,6 +606,8 @@ func alertSourceAttributeBetaFromAPI(
 
 		Expression:       expression,
 		NamedExpressions: named,
+
+		Timeouts: prior.Timeouts,
 	}
 
 	binding := models.ReconcileBinding(prior.binding(), ns.LocaliseBinding(&client.EngineParamBindingPayloadV3{
@@ -632,33 +660,30 @@ func (r *alertSourceAttributeBetaResource) attributeIsBound(ctx context.Context,
 	return err == nil && result.JSON200 != nil
 }
 
-// alertSourceAttributeConflict explains a 409 from a create. The API answers 409 both for an
-// attribute that is already bound and for losing a race on the source's config lock, and the
-// two need opposite advice — adopt the existing binding, or just run again. Which one it was
-// comes from asking whether the attribute is bound, not from reading the message.
-func alertSourceAttributeConflict(bound bool, sourceID, attributeID string, err error) (string, string) {
+// addAlertSourceAttributeConflict explains a 409 from a create. The API answers 409 both for
+// an attribute that is already bound and for losing a race on the source's config lock, and
+// the two need opposite advice — adopt the existing binding, or just run again. Which one it
+// was comes from asking whether the attribute is bound, not from reading the message.
+func addAlertSourceAttributeConflict(ctx context.Context, diags *diag.Diagnostics, schema attributeSchema, bound bool, sourceID, attributeID string, err error) {
 	if bound {
-		return "This attribute is already bound on this alert source",
+		addAPIError(ctx, diags, schema, "This attribute is already bound on this alert source",
 			fmt.Sprintf(
 				"Something else already fills this attribute in, either the dashboard or another "+
-					"Terraform resource. To manage it here instead, import it:\n\n"+
-					"    terraform import <address of this resource> %s\n\n"+
-					"Underlying error: %s",
+					"Terraform resource. To manage it here instead, import it with "+
+					"`terraform import <address of this resource> %s`",
 				alertSourceAttributeImportID(sourceID, attributeID),
-				err.Error(),
-			)
+			), err)
+
+		return
 	}
 
-	return "Unable to bind alert attribute", alertSourceAttributeContended(err)
+	addAPIError(ctx, diags, schema, "Client Error", alertSourceAttributeContended("Unable to bind alert attribute"), err)
 }
 
 // alertSourceAttributeContended explains the 409 that means a lost race rather than an existing
-// binding. Only a create has to tell the two apart: an attribute that isn't bound answers 404 to an
-// update or a destroy, so their only 409 is this one.
-func alertSourceAttributeContended(err error) string {
-	return fmt.Sprintf(
-		"The provider serialises writes to one alert source, and this one lost that race. Try "+
-			"again.\n\nUnderlying error: %s",
-		err.Error(),
-	)
+// binding, as the doing for addAPIError. Only a create has to tell the two apart: an attribute
+// that isn't bound answers 404 to an update or a destroy, so their only 409 is this one.
+func alertSourceAttributeContended(doing string) string {
+	return doing + " because another write to its alert source got there first, as the provider " +
+		"serialises them, so try again"
 }
@@ -103,8 +103,18 @@ func TestAlertSourceAttributeImportIDRejectsOnePart(t *testing.T) {
 func TestAlertSourceAttributeConflict(t *testing.T) {
 	err := client.HTTPError{StatusCode: 409, Body: []byte(`{"type":"conflict"}`)}
 
+	conflict := func(bound bool) (string, string) {
+		var diags diag.Diagnostics
+		addAlertSourceAttributeConflict(context.Background(), &diags, nil, bound, testAlertSourceID, testAlertAttributeID, err)
+		if len(diags) != 1 {
+			t.Fatalf("expected one diagnostic, got %+v", diags)
+		}
+
+		return diags[0].Summary(), diags[0].Detail()
+	}
+
 	t.Run("points at import when the attribute really is bound", func(t *testing.T) {
-		summary, detail := alertSourceAttributeConflict(true, testAlertSourceID, testAlertAttributeID, err)
+		summary, detail := conflict(true)
 
 		if !strings.Contains(summary, "already bound") {
 			t.Errorf("summary is %q, want it to say the attribute is bound", summary)
@@ -115,7 +125,7 @@ func TestAlertSourceAttributeConflict(t *testing.T) {
 	})
 
 	t.Run("says to run again when nothing was bound", func(t *testing.T) {
-		summary, detail := alertSourceAttributeConflict(false, testAlertSourceID, testAlertAttributeID, err)
+		summary, detail := conflict(false)
 
 		if strings.Contains(summary, "already bound") || strings.Contains(detail, "terraform import") {
 			t.Errorf("a contended write was reported as an existing binding: %s / %s", summary, detail)
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                   = &alertSourceBetaResource{}
 	_ resource.ResourceWithConfigure      = &alertSourceBetaResource{}
 	_ resource.ResourceWithImportState    = &alertSourceBetaResource{}
+	_ resource.ResourceWithIdentity       = &alertSourceBetaResource{}
 	_ resource.ResourceWithValidateConfig = &alertSourceBetaResource{}
 	_ resource.ResourceWithModifyPlan     = &alertSourceBetaResource{}
 )
@@ -82,14 +84,15 @@ type alertSourceBetaModel struct {
 	AutoResolveTimeoutMinutes types.Int64 `tfsdk:"auto_resolve_timeout_minutes"`
 	AutoResolveIncidentAlerts types.Bool  `tfsdk:"auto_resolve_incident_alerts"`
 
-	Version types.Int64 `tfsdk:"version"`
+	Version  types.Int64    `tfsdk:"version"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *alertSourceBetaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_source_beta"
 }
 
-func (r *alertSourceBetaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *alertSourceBetaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: fmt.Sprintf("%s\n\n%s", apischema.TagDocstring("Alert Sources V3"), `An alert source, without the attributes it populates — each of those is an `+"`incident_alert_source_attribute_beta`"+` resource. Editing one attribute therefore doesn't mean rewriting the source, and two people editing different attributes don't race each other.
 
@@ -213,6 +216,7 @@ compatible, so pin the provider version if that matters to you.
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts":         timeoutsBlock(ctx),
 			"named_expression": models.NamedExpressionBlock(),
 		},
 	}
@@ -559,6 +563,9 @@ func (r *alertSourceBetaResource) Create(ctx context.Context, req resource.Creat
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	expressions, bindings := r.toPayloads(&data, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -592,15 +599,16 @@ func (r *alertSourceBetaResource) Create(ctx context.Context, req resource.Creat
 		AlertSource: payload,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to create alert source", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert source", err)
 		return
 	}
 	if result.JSON201 == nil {
-		resp.Diagnostics.AddError("Unable to create alert source", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert source", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceBetaFromAPI(result.JSON201.AlertSource, &data, &resp.Diagnostics))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *alertSourceBetaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -610,24 +618,28 @@ func (r *alertSourceBetaResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.AlertSourcesV3ShowWithResponse(ctx, data.ID.ValueString())
 	if isNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read alert source", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert source", err)
 		return
 	}
 	// The client turns any non-2xx into an error, so a missing body is an unexpected success
 	// rather than a deleted source. Dropping it from state would have the next apply create a
 	// second one, so fail instead.
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to read alert source", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert source", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceBetaFromAPI(result.JSON200.AlertSource, &data, &resp.Diagnostics))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *alertSourceBetaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -638,6 +650,9 @@ func (r *alertSourceBetaResource) Update(ctx context.Context, req resource.Updat
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	expressions, bindings := r.toPayloads(&plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -691,15 +706,16 @@ func (r *alertSourceBetaResource) Update(ctx context.Context, req resource.Updat
 		AlertSource: payload,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update alert source", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert source", err)
 		return
 	}
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to update alert source", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert source", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, alertSourceBetaFromAPI(result.JSON200.AlertSource, &plan, &resp.Diagnostics))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *alertSourceBetaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -709,17 +725,28 @@ func (r *alertSourceBetaResource) Delete(ctx context.Context, req resource.Delet
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.AlertSourcesV3DestroyWithResponse(ctx, data.ID.ValueString())
 	if err != nil && !isNotFound(err) {
-		resp.Diagnostics.AddError("Unable to delete alert source", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete alert source", err)
 	}
 }
 
 func (r *alertSourceBetaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Create and Update carry the Terraform annotation in their payload, but an import writes
 	// nothing, so claim the source here instead.
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertSource, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertSource, r.terraformVersion)
+}
+
+func (r *alertSourceBetaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *alertSourceBetaResource) annotations() *map[string]string {
@@ -883,6 +910,8 @@ func alertSourceBetaFromAPI(
 		// Minted for email sources and absent for every other type, so it lives at the top
 		// level rather than inside the optional email_options block.
 		EmailAddress: types.StringNull(),
+
+		Timeouts: config.Timeouts,
 	}
 
 	if source.EmailOptions != nil {
@@ -0,0 +1,140 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// attributeSchema is the part of a resource or data source schema addAPIError needs, to
+// tell whether a field the API complains about is one of its attributes.
+type attributeSchema interface {
+	TypeAtPath(ctx context.Context, p path.Path) (attr.Type, diag.Diagnostics)
+}
+
+// addAPIError adds an error from a request to the API to diags, where doing says what we
+// were trying to do, such as "Unable to create severity". Each problem the API lists is
+// its own diagnostic, on the attribute it's about if schema has one by that name, and
+// names the request's ID to quote to support. schema may be nil when there isn't one to
+// hand, and doing may be empty if summary says it all.
+func addAPIError(ctx context.Context, diags *diag.Diagnostics, schema attributeSchema, summary, doing string, err error) {
+	readOnlyErr := client.ReadOnlyError{}
+	if errors.As(err, &readOnlyErr) {
+		diags.AddError("Refused by read-only mode", readOnlyErrorDetail(summary, doing, readOnlyErr))
+		return
+	}
+
+	httpErr := client.HTTPError{}
+	if !errors.As(err, &httpErr) {
+		diags.AddError(summary, apiErrorDetail(doing, err.Error(), ""))
+		return
+	}
+
+	envelope, ok := httpErr.Envelope()
+	if !ok || len(envelope.Errors) == 0 {
+		diags.AddError(summary, apiErrorDetail(doing, err.Error(), ""))
+		return
+	}
+
+	for _, detail := range envelope.Errors {
+		field := detail.Field()
+		if attributePath, ok := apiFieldPath(ctx, schema, field); ok {
+			diags.AddAttributeError(attributePath, summary, apiErrorDetail(doing, detail.Summary(), envelope.RequestID))
+			continue
+		}
+
+		message := detail.Summary()
+		if field != "" {
+			message = fmt.Sprintf("%s: %s", field, message)
+		}
+		diags.AddError(summary, apiErrorDetail(doing, message, envelope.RequestID))
+	}
+}
+
+func apiErrorDetail(doing, message, requestID string) string {
+	detail := message
+	if doing != "" {
+		detail = fmt.Sprintf("%s, got error: %s", doing, message)
+	}
+	if requestID != "" {
+		detail += fmt.Sprintf("\n\nRequest ID: %s", requestID)
+	}
+
+	return detail
+}
+
+// readOnlyErrorDetail says what read-only mode stopped the provider doing, such as
+// "create severity" from a doing of "Unable to create severity", and which request it held
+// back, so it's clear which resource a refused plan or apply was about.
+func readOnlyErrorDetail(summary, doing string, err client.ReadOnlyError) string {
+	refused := doing
+	if refused == "" {
+		refused = summary
+	}
+
+	action, ok := strings.CutPrefix(refused, "Unable to ")
+	if !ok {
+		action = fmt.Sprintf("make this change (%s)", refused)
+	}
+
+	return fmt.Sprintf("Read-only mode is on, so the provider refused to %s: the %s %s request that would "+
+		"make this change wasn't sent.\n\nSet read_only to false in the provider block, or unset "+
+		"INCIDENT_READ_ONLY, to make changes.", action, err.Method, err.Path)
+}
+
+// apiFieldStep matches one step of a field path from the API, such as `name` or `steps[0]`.
+var apiFieldStep = regexp.MustCompile(`^([^\[\]]+)((?:\[\d+\])*)$`)
+
+// apiFieldIndex matches each index in a step, such as the `[0]` of `steps[0]`.
+var apiFieldIndex = regexp.MustCompile(`\[(\d+)\]`)
+
+// apiFieldPath finds the attribute a field from the API is about, such as `name` or
+// `escalation_config.auto_cancel_escalations`. Where only the start of the field is an
+// attribute, such as the set an element was in, it returns that instead. It returns false
+// if the field isn't an attribute at all, as the API's name for something can differ from
+// ours.
+func apiFieldPath(ctx context.Context, schema attributeSchema, field string) (path.Path, bool) {
+	if schema == nil || field == "" {
+		return path.Empty(), false
+	}
+
+	// Each step is a name, such as `steps`, or an index into a list, such as `[0]` or `0`.
+	steps := []func(path.Path) path.Path{}
+	for _, part := range strings.Split(field, ".") {
+		if index, err := strconv.Atoi(part); err == nil {
+			steps = append(steps, func(p path.Path) path.Path { return p.AtListIndex(index) })
+			continue
+		}
+
+		match := apiFieldStep.FindStringSubmatch(part)
+		if match == nil {
+			break
+		}
+		steps = append(steps, func(p path.Path) path.Path { return p.AtName(match[1]) })
+		for _, index := range apiFieldIndex.FindAllStringSubmatch(match[2], -1) {
+			i, _ := strconv.Atoi(index[1])
+			steps = append(steps, func(p path.Path) path.Path { return p.AtListIndex(i) })
+		}
+	}
+
+	found, ok := path.Empty(), false
+	for _, step := range steps {
+		// Stop at the first step the schema doesn't have, or that indexes into a set.
+		next := step(found)
+		if _, diags := schema.TypeAtPath(ctx, next); diags.HasError() {
+			break
+		}
+		found, ok = next, true
+	}
+
+	return found, ok
+}
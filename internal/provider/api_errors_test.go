@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var apiErrorsTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"name": schema.StringAttribute{Required: true},
+		"escalation_config": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"auto_cancel_escalations": schema.BoolAttribute{Optional: true},
+			},
+		},
+		"targets": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Required: true},
+				},
+			},
+		},
+		"team_ids": schema.SetAttribute{Optional: true, ElementType: types.StringType},
+	},
+}
+
+func TestAddAPIError(t *testing.T) {
+	ctx := context.Background()
+
+	apiError := func(field string) error {
+		return client.HTTPError{StatusCode: 422, Body: []byte(`{"type": "validation_error", "status": 422, "request_id": "abc123",
+			"errors": [{"code": "invalid", "message": "That won't do", "source": {"field": "` + field + `"}}]}`)}
+	}
+
+	cases := []struct {
+		name   string
+		err    error
+		path   path.Path
+		detail string
+	}{
+		{
+			name:   "a field that's an attribute",
+			err:    apiError("name"),
+			path:   path.Root("name"),
+			detail: "Unable to create thing, got error: That won't do\n\nRequest ID: abc123",
+		},
+		{
+			name:   "a nested field",
+			err:    apiError("escalation_config.auto_cancel_escalations"),
+			path:   path.Root("escalation_config").AtName("auto_cancel_escalations"),
+			detail: "Unable to create thing, got error: That won't do\n\nRequest ID: abc123",
+		},
+		{
+			name:   "a field in a list",
+			err:    apiError("targets[1].id"),
+			path:   path.Root("targets").AtListIndex(1).AtName("id"),
+			detail: "Unable to create thing, got error: That won't do\n\nRequest ID: abc123",
+		},
+		{
+			name:   "an element of a set",
+			err:    apiError("team_ids.0"),
+			path:   path.Root("team_ids"),
+			detail: "Unable to create thing, got error: That won't do\n\nRequest ID: abc123",
+		},
+		{
+			name:   "a field that isn't an attribute",
+			err:    apiError("role_ids"),
+			path:   path.Empty(),
+			detail: "Unable to create thing, got error: role_ids: That won't do\n\nRequest ID: abc123",
+		},
+		{
+			name:   "an error that isn't from the API",
+			err:    errors.New("connection refused"),
+			path:   path.Empty(),
+			detail: "Unable to create thing, got error: connection refused",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			addAPIError(ctx, &diags, apiErrorsTestSchema, "Client Error", "Unable to create thing", tc.err)
+
+			if assert.Len(t, diags, 1) {
+				assert.Equal(t, "Client Error", diags[0].Summary())
+				assert.Equal(t, tc.detail, diags[0].Detail())
+
+				gotPath := path.Empty()
+				if withPath, ok := diags[0].(diag.DiagnosticWithPath); ok {
+					gotPath = withPath.Path()
+				}
+				assert.Equal(t, tc.path, gotPath)
+			}
+		})
+	}
+
+	t.Run("a change refused by read-only mode", func(t *testing.T) {
+		err := client.ReadOnlyError{Method: "POST", Path: "/v1/severities"}
+
+		var diags diag.Diagnostics
+		addAPIError(ctx, &diags, apiErrorsTestSchema, "Client Error", "Unable to create severity", err)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Refused by read-only mode", "Read-only mode is on, so the provider refused to "+
+				"create severity: the POST /v1/severities request that would make this change wasn't sent.\n\n"+
+				"Set read_only to false in the provider block, or unset INCIDENT_READ_ONLY, to make changes."),
+		}, diags)
+	})
+
+	t.Run("read-only mode names what summary says was refused", func(t *testing.T) {
+		err := client.ReadOnlyError{Method: "DELETE", Path: "/v1/severities/01SEV"}
+
+		var diags diag.Diagnostics
+		addAPIError(ctx, &diags, nil, "Unable to delete severity", "", err)
+
+		if assert.Len(t, diags, 1) {
+			assert.Contains(t, diags[0].Detail(), "refused to delete severity: the DELETE /v1/severities/01SEV request")
+		}
+	})
+
+	t.Run("one diagnostic for each problem", func(t *testing.T) {
+		err := client.HTTPError{StatusCode: 422, Body: []byte(`{"type": "validation_error", "request_id": "abc123", "errors": [
+			{"code": "is_required", "message": "Name is required", "source": {"field": "name"}},
+			{"code": "invalid", "message": "Something else is wrong"}]}`)}
+
+		var diags diag.Diagnostics
+		addAPIError(ctx, &diags, nil, "Unable to create thing", "", err)
+
+		assert.Equal(t, diag.Diagnostics{
+			diag.NewErrorDiagnostic("Unable to create thing", "name: Name is required\n\nRequest ID: abc123"),
+			diag.NewErrorDiagnostic("Unable to create thing", "Something else is wrong\n\nRequest ID: abc123"),
+		}, diags)
+	})
+}
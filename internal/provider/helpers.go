@@ -35,6 +35,16 @@ func enumValues(definitionName string, propertyName string) []string {
 	return values
 }
 
+// backtickValues formats enum values for a description, as EnumValuesDescription does.
+func backtickValues(values []string) string {
+	quoted := []string{}
+	for _, value := range values {
+		quoted = append(quoted, "`"+value+"`")
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
 // knownString returns the value of a string attribute, and false when it's missing, null
 // or unknown — none of which can be judged at plan time.
 func knownString(value attr.Value) (string, bool) {
@@ -55,3 +65,13 @@ func knownInt64(value attr.Value) (int64, bool) {
 
 	return number.ValueInt64(), true
 }
+
+// knownBool is knownString for a bool attribute.
+func knownBool(value attr.Value) (bool, bool) {
+	boolean, ok := value.(types.Bool)
+	if !ok || boolean.IsNull() || boolean.IsUnknown() {
+		return false, false
+	}
+
+	return boolean.ValueBool(), true
+}
@@ -89,7 +89,7 @@ func (i *IncidentAlertAttributeDataSource) Read(ctx context.Context, req datasou
 
 	result, err := i.client.AlertAttributesV2ListWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert attributes, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert attributes", err)
 		return
 	}
 
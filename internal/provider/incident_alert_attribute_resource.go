@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentAlertAttributeResource{}
 	_ resource.ResourceWithImportState = &IncidentAlertAttributeResource{}
+	_ resource.ResourceWithIdentity    = &IncidentAlertAttributeResource{}
 )
 
 type IncidentAlertAttributeResource struct {
@@ -29,12 +30,13 @@ type IncidentAlertAttributeResource struct {
 }
 
 type IncidentAlertAttributeResourceModel struct {
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Type     types.String `tfsdk:"type"`
-	Array    types.Bool   `tfsdk:"array"`
-	Required types.Bool   `tfsdk:"required"`
-	Emoji    types.String `tfsdk:"emoji"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Type     types.String   `tfsdk:"type"`
+	Array    types.Bool     `tfsdk:"array"`
+	Required types.Bool     `tfsdk:"required"`
+	Emoji    types.String   `tfsdk:"emoji"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentAlertAttributeResource() resource.Resource {
@@ -78,6 +80,9 @@ func (r *IncidentAlertAttributeResource) Schema(ctx context.Context, req resourc
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -107,6 +112,9 @@ func (r *IncidentAlertAttributeResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	requestBody := client.AlertAttributesCreatePayloadV2{
 		Name:     data.Name.ValueString(),
 		Type:     data.Type.ValueString(),
@@ -123,13 +131,16 @@ func (r *IncidentAlertAttributeResource) Create(ctx context.Context, req resourc
 		return result, nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert attribute, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert attribute", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an alert attribute resource with id=%s", result.JSON201.AlertAttribute.Id))
-	data = r.buildModel(result.JSON201.AlertAttribute, data.Required)
+	model := r.buildModel(result.JSON201.AlertAttribute, data.Required)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -139,6 +150,9 @@ func (r *IncidentAlertAttributeResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.AlertAttributesV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -148,12 +162,15 @@ func (r *IncidentAlertAttributeResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert attribute, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert attribute", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.AlertAttribute, data.Required)
+	model := r.buildModel(result.JSON200.AlertAttribute, data.Required)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -163,6 +180,9 @@ func (r *IncidentAlertAttributeResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	requestBody := client.AlertAttributesV2UpdateJSONRequestBody{
 		Name:  data.Name.ValueString(),
 		Type:  data.Type.ValueString(),
@@ -185,12 +205,15 @@ func (r *IncidentAlertAttributeResource) Update(ctx context.Context, req resourc
 		return result, nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert attribute, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert attribute", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.AlertAttribute, data.Required)
+	model := r.buildModel(result.JSON200.AlertAttribute, data.Required)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -200,17 +223,20 @@ func (r *IncidentAlertAttributeResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := lockForAlertConfig(ctx, func(ctx context.Context) (*client.AlertAttributesV2DestroyResponse, error) {
 		return r.client.AlertAttributesV2DestroyWithResponse(ctx, data.ID.ValueString())
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert attribute, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete alert attribute", err)
 		return
 	}
 }
 
 func (r *IncidentAlertAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
 
 	// After import, we need to read the full resource and set all attributes
 	// including the required field based on API response
@@ -219,24 +245,29 @@ func (r *IncidentAlertAttributeResource) ImportState(ctx context.Context, req re
 	}
 
 	// Get the resource data from API
-	result, err := r.client.AlertAttributesV2ShowWithResponse(ctx, req.ID)
+	result, err := r.client.AlertAttributesV2ShowWithResponse(ctx, id)
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
 		httpErr := client.HTTPError{}
 		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
-			tflog.Warn(ctx, fmt.Sprintf("Alert attribute with ID %s not found: removing from state.", req.ID))
+			tflog.Warn(ctx, fmt.Sprintf("Alert attribute with ID %s not found: removing from state.", id))
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert attribute, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert attribute", err)
 		return
 	}
 
 	// For import, always set required based on API response
 	data := r.buildModel(result.JSON200.AlertAttribute, types.BoolValue(result.JSON200.AlertAttribute.Required))
+	data.Timeouts = nullTimeouts(ctx)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *IncidentAlertAttributeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
 func (r *IncidentAlertAttributeResource) buildModel(alertAttribute client.AlertAttributeV2, configuredRequired types.Bool) *IncidentAlertAttributeResourceModel {
 	model := &IncidentAlertAttributeResourceModel{
 		ID:    types.StringValue(alertAttribute.Id),
@@ -3,10 +3,11 @@ package provider
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -26,6 +28,8 @@ var (
 	_ resource.ResourceWithConfigure      = &IncidentAlertRouteResource{}
 	_ resource.ResourceWithImportState    = &IncidentAlertRouteResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentAlertRouteResource{}
+	_ resource.ResourceWithIdentity       = &IncidentAlertRouteResource{}
+	_ importSeeder                        = &IncidentAlertRouteResource{}
 )
 
 // changelogMigrationRef points users at the versioned migration guide. It is
@@ -58,6 +62,10 @@ func NewIncidentAlertRouteResource() resource.Resource {
 	return &IncidentAlertRouteResource{}
 }
 
+func NewIncidentAlertRouteListResource() list.ListResource {
+	return newIDListResource(NewIncidentAlertRouteResource, listAlertRouteResources)
+}
+
 func (r *IncidentAlertRouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_alert_route"
 }
@@ -376,6 +384,9 @@ func (r *IncidentAlertRouteResource) Schema(ctx context.Context, req resource.Sc
 				MarkdownDescription: apischema.Docstring("AlertRouteV2", "owning_team_ids"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -494,6 +505,9 @@ func (r *IncidentAlertRouteResource) Create(ctx context.Context, req resource.Cr
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	if data.IsV3Mode() {
 		result, err := r.client.AlertRoutesV3CreateWithResponse(ctx, data.ToCreatePayloadV3())
 		if err != nil {
@@ -501,7 +515,7 @@ func (r *IncidentAlertRouteResource) Create(ctx context.Context, req resource.Cr
 				resp.Diagnostics.AddError(alertRouteV3UnavailableError())
 				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert route, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert route", err)
 			return
 		}
 
@@ -509,13 +523,15 @@ func (r *IncidentAlertRouteResource) Create(ctx context.Context, req resource.Cr
 		tflog.Trace(ctx, fmt.Sprintf("Created an alert route with id=%s", result.JSON201.AlertRoute.Id))
 
 		data = models.AlertRouteResourceModel{}.FromAPIV3WithPlan(result.JSON201.AlertRoute, &plan)
+		data.Timeouts = plan.Timeouts
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 		return
 	}
 
 	result, err := r.client.AlertRoutesV2CreateWithResponse(ctx, data.ToCreatePayloadV2())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert route, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert route", err)
 		return
 	}
 
@@ -523,7 +539,9 @@ func (r *IncidentAlertRouteResource) Create(ctx context.Context, req resource.Cr
 	tflog.Trace(ctx, fmt.Sprintf("Created an alert route with id=%s", result.JSON201.AlertRoute.Id))
 
 	data = models.AlertRouteResourceModel{}.FromAPIV2WithPlan(result.JSON201.AlertRoute, &plan)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -536,6 +554,9 @@ func (r *IncidentAlertRouteResource) Read(ctx context.Context, req resource.Read
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, state.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	// The schema in use is recorded in state (grouping_config is set for v3), so
 	// Read can dispatch to the matching API without the configuration.
 	if data.IsV3Mode() {
@@ -546,12 +567,14 @@ func (r *IncidentAlertRouteResource) Read(ctx context.Context, req resource.Read
 				resp.State.RemoveResource(ctx)
 				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert route, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert route", err)
 			return
 		}
 
 		data = models.AlertRouteResourceModel{}.FromAPIV3WithPlan(result.JSON200.AlertRoute, &state)
+		data.Timeouts = state.Timeouts
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 		return
 	}
 
@@ -562,12 +585,14 @@ func (r *IncidentAlertRouteResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert route, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert route", err)
 		return
 	}
 
 	data = models.AlertRouteResourceModel{}.FromAPIV2WithPlan(result.JSON200.AlertRoute, &state)
+	data.Timeouts = state.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -580,6 +605,9 @@ func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Dispatch on the planned schema. Both APIs address the same underlying
 	// alert route, so a v2 -> v3 migration (adding grouping_config) is an update,
 	// not a replacement.
@@ -595,7 +623,7 @@ func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.Up
 				resp.Diagnostics.AddError(alertRouteV3UnavailableError())
 				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert route before updating, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert route before updating", err)
 			return
 		}
 
@@ -608,14 +636,16 @@ func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.Up
 				resp.Diagnostics.AddError(alertRouteV3UnavailableError())
 				return
 			}
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert route, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert route", err)
 			return
 		}
 
 		claimResource(ctx, r.client, showResult.JSON200.AlertRoute.Id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertRoute, r.terraformVersion)
 
 		data = models.AlertRouteResourceModel{}.FromAPIV3WithPlan(updateResult.JSON200.AlertRoute, &plan)
+		data.Timeouts = plan.Timeouts
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 		return
 	}
 
@@ -626,7 +656,7 @@ func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.Up
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert route before updating, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert route before updating", err)
 		return
 	}
 
@@ -635,14 +665,16 @@ func (r *IncidentAlertRouteResource) Update(ctx context.Context, req resource.Up
 
 	updateResult, err := r.client.AlertRoutesV2UpdateWithResponse(ctx, data.ID.ValueString(), payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert route, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert route", err)
 		return
 	}
 
 	claimResource(ctx, r.client, showResult.JSON200.AlertRoute.Id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertRoute, r.terraformVersion)
 
 	data = models.AlertRouteResourceModel{}.FromAPIV2WithPlan(updateResult.JSON200.AlertRoute, &plan)
+	data.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -652,45 +684,59 @@ func (r *IncidentAlertRouteResource) Delete(ctx context.Context, req resource.De
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	if data.IsV3Mode() {
 		_, err := r.client.AlertRoutesV3DeleteWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert route, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete alert route", err)
 		}
 		return
 	}
 
 	_, err := r.client.AlertRoutesV2DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert route, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete alert route", err)
 	}
 }
 
 func (r *IncidentAlertRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// The same underlying alert route is readable through both the v2 and v3
-	// APIs, and Read infers the schema from state (which is empty on import), so
-	// we decide the schema here by probing. Organisations migrated to the new
-	// alert grouping engine are imported with the v3 schema; organisations that
-	// haven't migrated get a 403 `api_not_yet_available` from the v3 API, so we
-	// fall back to the v2 API and import with the v2 schema. We populate the full
-	// state via the matching API so the subsequent refresh dispatches correctly.
-	id := req.ID
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertRoute, r.terraformVersion)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	r.seedImportState(ctx, id, &resp.State, &resp.Diagnostics)
+}
+
+// seedImportState populates the state of an alert route being imported, or listed by
+// `terraform query`.
+//
+// The same underlying alert route is readable through both the v2 and v3 APIs, and Read
+// infers the schema from state (which is empty on import), so we decide the schema here
+// by probing. Organisations migrated to the new alert grouping engine are imported with
+// the v3 schema; organisations that haven't migrated get a 403 `api_not_yet_available`
+// from the v3 API, so we fall back to the v2 API and import with the v2 schema. We
+// populate the full state via the matching API so the subsequent refresh dispatches
+// correctly.
+func (r *IncidentAlertRouteResource) seedImportState(ctx context.Context, id string, state *tfsdk.State, diags *diag.Diagnostics) {
 	v3Result, err := r.client.AlertRoutesV3ShowWithResponse(ctx, id)
 	switch {
 	case err == nil && v3Result.JSON200 != nil:
 		data := models.AlertRouteResourceModel{}.FromAPIV3(v3Result.JSON200.AlertRoute)
-		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		data.Timeouts = nullTimeouts(ctx)
+		diags.Append(state.Set(ctx, &data)...)
 		return
 	case err != nil && !isAPINotYetAvailable(err):
 		// A genuine error rather than the migration gate: surface it rather than
 		// masking it behind a v2 fallback.
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import alert route, got error: %s", err))
+		addAPIError(ctx, diags, nil, "Client Error", "Unable to import alert route", err)
 		return
 	}
 
@@ -698,15 +744,20 @@ func (r *IncidentAlertRouteResource) ImportState(ctx context.Context, req resour
 	// API returned `api_not_yet_available`), so import via the v2 API.
 	v2Result, err := r.client.AlertRoutesV2ShowWithResponse(ctx, id)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import alert route, got error: %s", err))
+		addAPIError(ctx, diags, nil, "Client Error", "Unable to import alert route", err)
 		return
 	}
 	if v2Result.JSON200 == nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import alert route %q: not found.", id))
+		addAPIError(ctx, diags, nil, "Client Error", fmt.Sprintf("Unable to import alert route %q", id), errors.New("not found"))
 		return
 	}
 	data := models.AlertRouteResourceModel{}.FromAPIV2(v2Result.JSON200.AlertRoute)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	data.Timeouts = nullTimeouts(ctx)
+	diags.Append(state.Set(ctx, &data)...)
+}
+
+func (r *IncidentAlertRouteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 // isNotFound reports whether err is a 404 from the API.
@@ -729,15 +780,10 @@ func isAPINotYetAvailable(err error) bool {
 		return false
 	}
 
-	// incident.io error envelope: {"errors": [{"code": "..."}], ...}. Parse it,
-	// falling back to a raw substring match in case the envelope shape differs.
-	var body struct {
-		Errors []struct {
-			Code string `json:"code"`
-		} `json:"errors"`
-	}
-	if json.Unmarshal(httpErr.Body, &body) == nil {
-		for _, e := range body.Errors {
+	// Check the codes in the error envelope, falling back to a raw substring match in
+	// case the envelope shape differs.
+	if envelope, ok := httpErr.Envelope(); ok {
+		for _, e := range envelope.Errors {
 			if e.Code == alertRouteAPINotYetAvailableCode {
 				return true
 			}
@@ -756,3 +802,35 @@ func alertRouteV3UnavailableError() (summary, detail string) {
 			"isn't available for your organisation yet. Remove `grouping_config` (and " +
 			"the other blocks that depend on it) and use the deprecated attributes instead."
 }
+
+// listAlertRouteResources lists all alert routes for `terraform query`, a page at a time.
+func listAlertRouteResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	var (
+		after  *string
+		listed = []listedResource{}
+	)
+
+	for {
+		result, err := apiClient.AlertRoutesV2ListWithResponse(ctx, &client.AlertRoutesV2ListParams{
+			PageSize: int64(listResourcePageSize),
+			After:    after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response listing alert routes: %s", result.Status())
+		}
+
+		for _, item := range result.JSON200.AlertRoutes {
+			listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+		}
+
+		after = result.JSON200.PaginationMeta.After
+		if after == nil {
+			break
+		}
+	}
+
+	return listed, nil
+}
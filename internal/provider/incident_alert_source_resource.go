@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -29,6 +30,7 @@ var (
 	_ resource.ResourceWithImportState    = &IncidentAlertSourceResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentAlertSourceResource{}
 	_ resource.ResourceWithModifyPlan     = &IncidentAlertSourceResource{}
+	_ resource.ResourceWithIdentity       = &IncidentAlertSourceResource{}
 )
 
 type IncidentAlertSourceResource struct {
@@ -256,6 +258,10 @@ func NewIncidentAlertSourceResource() resource.Resource {
 	return &IncidentAlertSourceResource{}
 }
 
+func NewIncidentAlertSourceListResource() list.ListResource {
+	return newIDListResource(NewIncidentAlertSourceResource, listAlertSourceResources)
+}
+
 // alertSourceValidateTimeout bounds the plan-time template check. Long enough that a
 // slow-but-working API still answers, short enough that an unhealthy one costs a plan
 // seconds rather than minutes. A var so tests don't have to wait it out.
@@ -612,6 +618,9 @@ func (r *IncidentAlertSourceResource) Schema(ctx context.Context, req resource.S
 				MarkdownDescription: apischema.Docstring("AlertSourceV2", "owning_team_ids"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -640,6 +649,9 @@ func (r *IncidentAlertSourceResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := lockForAlertConfig(ctx, func(ctx context.Context) (*client.AlertSourcesV2CreateResponse, error) {
 		owningTeamIDs := owningTeamIDsPayload(data.OwningTeamIDs)
 
@@ -668,7 +680,7 @@ func (r *IncidentAlertSourceResource) Create(ctx context.Context, req resource.C
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create alert source, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create alert source", err)
 		return
 	}
 
@@ -680,7 +692,9 @@ func (r *IncidentAlertSourceResource) Create(ctx context.Context, req resource.C
 	planAutoResolveIncidentAlerts := data.AutoResolveIncidentAlerts
 	planEmailOptions := data.EmailOptions
 
-	data = models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model := models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model.Timeouts = data.Timeouts
+	data = model
 
 	// When auto_resolve_timeout_minutes isn't set, the API ignores
 	// auto_resolve_incident_alerts and won't return it. Preserve the
@@ -703,6 +717,7 @@ func (r *IncidentAlertSourceResource) Create(ctx context.Context, req resource.C
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -712,6 +727,9 @@ func (r *IncidentAlertSourceResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.AlertSourcesV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -721,7 +739,7 @@ func (r *IncidentAlertSourceResource) Read(ctx context.Context, req resource.Rea
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read alert source, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read alert source", err)
 		return
 	}
 
@@ -729,7 +747,9 @@ func (r *IncidentAlertSourceResource) Read(ctx context.Context, req resource.Rea
 	stateAutoResolveIncidentAlerts := data.AutoResolveIncidentAlerts
 	stateEmailOptions := data.EmailOptions
 
-	data = models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model := models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model.Timeouts = data.Timeouts
+	data = model
 
 	// When auto_resolve_timeout_minutes isn't set, the API ignores
 	// auto_resolve_incident_alerts and won't return it. Preserve the
@@ -750,6 +770,7 @@ func (r *IncidentAlertSourceResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -759,6 +780,9 @@ func (r *IncidentAlertSourceResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := lockForAlertConfig(ctx, func(ctx context.Context) (*client.AlertSourcesV2UpdateResponse, error) {
 		var owningTeamIDs *[]string
 		if !data.OwningTeamIDs.IsNull() {
@@ -793,7 +817,7 @@ func (r *IncidentAlertSourceResource) Update(ctx context.Context, req resource.U
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update alert source, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update alert source", err)
 		return
 	}
 
@@ -803,7 +827,9 @@ func (r *IncidentAlertSourceResource) Update(ctx context.Context, req resource.U
 	planAutoResolveIncidentAlerts := data.AutoResolveIncidentAlerts
 	planEmailOptions := data.EmailOptions
 
-	data = models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model := models.AlertSourceResourceModel{}.FromAPI(result.JSON200.AlertSource)
+	model.Timeouts = data.Timeouts
+	data = model
 
 	// When auto_resolve_timeout_minutes isn't set, the API ignores
 	// auto_resolve_incident_alerts and won't return it. Preserve the
@@ -826,6 +852,7 @@ func (r *IncidentAlertSourceResource) Update(ctx context.Context, req resource.U
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentAlertSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -835,16 +862,45 @@ func (r *IncidentAlertSourceResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := lockForAlertConfig(ctx, func(ctx context.Context) (*client.AlertSourcesV2DeleteResponse, error) {
 		return r.client.AlertSourcesV2DeleteWithResponse(ctx, data.ID.ValueString())
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete alert source, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete alert source", err)
 		return
 	}
 }
 
 func (r *IncidentAlertSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertSource, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeAlertSource, r.terraformVersion)
+}
+
+func (r *IncidentAlertSourceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// listAlertSourceResources lists all alert sources for `terraform query`.
+func listAlertSourceResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.AlertSourcesV2ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing alert sources: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.AlertSources {
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
 }
@@ -75,7 +75,7 @@ func (d *IncidentAlertSourcesDataSource) Read(ctx context.Context, req datasourc
 	// Get all alert sources
 	result, err := d.client.AlertSourcesV2ListWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list alert sources, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list alert sources", err)
 		return
 	}
 
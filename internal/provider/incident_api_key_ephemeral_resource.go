@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &IncidentAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &IncidentAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &IncidentAPIKeyEphemeralResource{}
+)
+
+// apiKeyPrivateKey is where Open leaves the ID of the key it created, for Close to
+// delete.
+const apiKeyPrivateKey = "api_key"
+
+type IncidentAPIKeyEphemeralResource struct {
+	// keys creates and reads keys just as the incident_api_key resource does.
+	keys *IncidentAPIKeyResource
+}
+
+type IncidentAPIKeyEphemeralResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Name          types.String `tfsdk:"name"`
+	Comments      types.String `tfsdk:"comments"`
+	RoleNames     types.Set    `tfsdk:"role_names"`
+	TeamIDs       types.Set    `tfsdk:"team_ids"`
+	TeamRoleNames types.Set    `tfsdk:"team_role_names"`
+	Token         types.String `tfsdk:"token"`
+}
+
+// apiKeyPrivateData is what Open leaves for Close, as JSON.
+type apiKeyPrivateData struct {
+	ID string `json:"id"`
+}
+
+func NewIncidentAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &IncidentAPIKeyEphemeralResource{keys: &IncidentAPIKeyResource{}}
+}
+
+func (r *IncidentAPIKeyEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *IncidentAPIKeyEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Create a short-lived API key for the length of a Terraform run, and delete it once
+the run is done. The key and its token are never stored in state or plan files.
+
+Use it to give another provider or tool incident.io credentials that only it needs, and
+only while Terraform runs, such as a provider that reads from incident.io to configure
+something else. A key is created each time Terraform opens this ephemeral resource,
+during plan as well as apply, and deleted when Terraform closes it. Anything still using
+the token after the run will find it no longer works: for a token that outlives the run,
+manage the key with the ` + "`incident_api_key`" + ` resource and rotate it with the
+` + "`incident_api_key_token`" + ` ephemeral resource instead.
+
+Ephemeral resources need Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "id"),
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "name"),
+				Required:            true,
+			},
+			"comments": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "comments"),
+				Optional:            true,
+			},
+			"role_names": schema.SetAttribute{
+				MarkdownDescription: apiKeyRoleNamesDescription("APIKeysCreatePayloadV1", "role_names", "APIKeyRoleV1"),
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeysCreatePayloadV1", "team_ids"),
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"team_role_names": schema.SetAttribute{
+				MarkdownDescription: apiKeyRoleNamesDescription("APIKeysCreatePayloadV1", "team_role_names", "APIKeyTeamRoleV1"),
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeysCreateResultV1", "token"),
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *IncidentAPIKeyEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.keys.client = client.Client
+}
+
+func (r *IncidentAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data IncidentAPIKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := r.keys.create(ctx, &IncidentAPIKeyResourceModel{
+		Name:          data.Name,
+		Comments:      data.Comments,
+		RoleNames:     data.RoleNames,
+		TeamIDs:       data.TeamIDs,
+		TeamRoleNames: data.TeamRoleNames,
+	}, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("created an ephemeral API key with id=%s", result.ApiKey.Id))
+
+	// Remember the key before anything else can fail, so Close always deletes it.
+	private, err := json.Marshal(apiKeyPrivateData{ID: result.ApiKey.Id})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, nil, "Client Error", "Unable to record the API key to delete", err)
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, apiKeyPrivateKey, private)...)
+
+	key := r.keys.buildModel(result.ApiKey, &IncidentAPIKeyResourceModel{
+		TeamIDs:       data.TeamIDs,
+		TeamRoleNames: data.TeamRoleNames,
+	})
+	data.ID = key.ID
+	data.Name = key.Name
+	data.Comments = key.Comments
+	data.RoleNames = key.RoleNames
+	data.TeamIDs = key.TeamIDs
+	data.TeamRoleNames = key.TeamRoleNames
+	data.Token = types.StringValue(result.Token)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *IncidentAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	private, diags := req.Private.GetKey(ctx, apiKeyPrivateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || private == nil {
+		return
+	}
+
+	var data apiKeyPrivateData
+	if err := json.Unmarshal(private, &data); err != nil {
+		addAPIError(ctx, &resp.Diagnostics, nil, "Client Error", "Unable to read the API key to delete", err)
+		return
+	}
+
+	_, err := r.keys.client.APIKeysV1DeleteWithResponse(ctx, data.ID)
+	if err != nil {
+		httpErr := client.HTTPError{}
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			tflog.Warn(ctx, fmt.Sprintf("ephemeral API key with ID %s was already deleted", data.ID))
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, nil, "Client Error", fmt.Sprintf("Unable to delete ephemeral API key %s, which will keep working until you delete it from the dashboard", data.ID), err)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("deleted ephemeral API key with id=%s", data.ID))
+}
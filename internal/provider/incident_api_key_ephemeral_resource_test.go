@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAPIKeyEphemeralResourceLifecycle checks a key is created when Terraform opens the
+// ephemeral resource, and deleted when it closes it.
+func TestAPIKeyEphemeralResourceLifecycle(t *testing.T) {
+	ctx := context.Background()
+
+	var created map[string]any
+	deleted := []string{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /v1/api_keys", func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(body, &created)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"token": "inc_secret", "api_key": {"id": "01KEY", "name": "CI", "roles": [{"name": "viewer"}],
+			"team_ids": [], "team_roles": [], "created_at": "2024-01-01T00:00:00Z", "token_last_issued_at": "2024-01-01T00:00:00Z",
+			"creator": {}}}`))
+	})
+	mux.HandleFunc("DELETE /v1/api_keys/{id}", func(w http.ResponseWriter, r *http.Request) {
+		deleted = append(deleted, r.PathValue("id"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+	providerServer, diags := testProviderServer(t, mux, nil)
+	if len(diags) > 0 {
+		t.Fatalf("configuring the provider: %+v", diags)
+	}
+
+	keyType, keyConfig := testAPIKeyEphemeralConfig()
+
+	openResp, err := providerServer.OpenEphemeralResource(ctx, &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "incident_api_key",
+		Config:   keyConfig,
+	})
+	if err != nil || len(openResp.Diagnostics) > 0 {
+		t.Fatalf("opening the ephemeral resource: %v %+v", err, openResp.Diagnostics)
+	}
+
+	assert.Equal(t, "CI", created["name"])
+	assert.Equal(t, []any{"viewer"}, created["role_names"])
+
+	result, err := openResp.Result.Unmarshal(keyType)
+	if err != nil {
+		t.Fatalf("reading the result: %v", err)
+	}
+	values := map[string]tftypes.Value{}
+	_ = result.As(&values)
+
+	var id, token string
+	_ = values["id"].As(&id)
+	_ = values["token"].As(&token)
+	assert.Equal(t, "01KEY", id)
+	assert.Equal(t, "inc_secret", token)
+	assert.True(t, values["team_ids"].IsNull(), "an unset team scope stays null")
+	assert.Empty(t, deleted, "the key lives until Terraform closes it")
+
+	closeResp, err := providerServer.CloseEphemeralResource(ctx, &tfprotov6.CloseEphemeralResourceRequest{
+		TypeName: "incident_api_key",
+		Private:  openResp.Private,
+	})
+	if err != nil || len(closeResp.Diagnostics) > 0 {
+		t.Fatalf("closing the ephemeral resource: %v %+v", err, closeResp.Diagnostics)
+	}
+
+	assert.Equal(t, []string{"01KEY"}, deleted)
+}
+
+// testAPIKeyEphemeralConfig is the config of an incident_api_key ephemeral resource, and
+// its type.
+func testAPIKeyEphemeralConfig() (tftypes.Object, *tfprotov6.DynamicValue) {
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	keyType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":              tftypes.String,
+		"name":            tftypes.String,
+		"comments":        tftypes.String,
+		"role_names":      stringSet,
+		"team_ids":        stringSet,
+		"team_role_names": stringSet,
+		"token":           tftypes.String,
+	}}
+	keyConfig, _ := tfprotov6.NewDynamicValue(keyType, tftypes.NewValue(keyType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, nil),
+		"name":     tftypes.NewValue(tftypes.String, "CI"),
+		"comments": tftypes.NewValue(tftypes.String, nil),
+		"role_names": tftypes.NewValue(stringSet, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "viewer"),
+		}),
+		"team_ids":        tftypes.NewValue(stringSet, nil),
+		"team_role_names": tftypes.NewValue(stringSet, nil),
+		"token":           tftypes.NewValue(tftypes.String, nil),
+	}))
+
+	return keyType, &keyConfig
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                = &IncidentAPIKeyResource{}
+	_ resource.ResourceWithConfigure   = &IncidentAPIKeyResource{}
+	_ resource.ResourceWithImportState = &IncidentAPIKeyResource{}
+	_ resource.ResourceWithIdentity    = &IncidentAPIKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &IncidentAPIKeyResource{}
+)
+
+// apiKeyDefaultGracePeriodMinutes keeps the previous token alive for long enough that
+// whatever was using it can pick up the new one.
+const apiKeyDefaultGracePeriodMinutes = 60
+
+type IncidentAPIKeyResource struct {
+	client *client.ClientWithResponses
+	tokens *issuedAPIKeyTokens
+}
+
+type IncidentAPIKeyResourceModel struct {
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	Comments           types.String   `tfsdk:"comments"`
+	RoleNames          types.Set      `tfsdk:"role_names"`
+	TeamIDs            types.Set      `tfsdk:"team_ids"`
+	TeamRoleNames      types.Set      `tfsdk:"team_role_names"`
+	RotationTrigger    types.String   `tfsdk:"rotation_trigger"`
+	GracePeriodMinutes types.Int64    `tfsdk:"grace_period_minutes"`
+	TokenLastIssuedAt  types.String   `tfsdk:"token_last_issued_at"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentAPIKeyResource() resource.Resource {
+	return &IncidentAPIKeyResource{}
+}
+
+func (r *IncidentAPIKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+func (r *IncidentAPIKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage an incident.io API key: its name and the roles it's granted.
+
+The key's token is never stored in state. The API only returns a token when a key is
+created or rotated, which this resource does on apply: change ` + "`rotation_trigger`" + ` to a
+new value to rotate the key. Planning never rotates it, and nor does removing the trigger.
+Read the token issued in the same apply with the ` + "`incident_api_key_token`" + ` ephemeral
+resource, and pass it to other providers through their write-only or ephemeral
+attributes.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "name"),
+				Required:            true,
+			},
+			"comments": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "comments"),
+				Optional:            true,
+			},
+			"role_names": schema.SetAttribute{
+				MarkdownDescription: apiKeyRoleNamesDescription("APIKeysCreatePayloadV1", "role_names", "APIKeyRoleV1"),
+				Required:            true,
+				ElementType:         types.StringType,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeysCreatePayloadV1", "team_ids"),
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"team_role_names": schema.SetAttribute{
+				MarkdownDescription: apiKeyRoleNamesDescription("APIKeysCreatePayloadV1", "team_role_names", "APIKeyTeamRoleV1"),
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value, such as a version number or a date. Changing it to a new value rotates the key, issuing a new token. Removing it doesn't rotate the key.",
+				Optional:            true,
+			},
+			"grace_period_minutes": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("%s Defaults to %d.", apischema.Docstring("APIKeysRotatePayloadV1", "grace_period_minutes"), apiKeyDefaultGracePeriodMinutes),
+				Optional:            true,
+			},
+			"token_last_issued_at": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeyV1", "token_last_issued_at"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+// apiKeyRoleNamesDescription documents a list of role names with the roles the API
+// accepts, which it only declares on the role objects it returns.
+func apiKeyRoleNamesDescription(payloadName, propertyName, roleDefinitionName string) string {
+	roles := []string{}
+	for _, role := range enumValues(roleDefinitionName, "name") {
+		roles = append(roles, "`"+role+"`")
+	}
+
+	return fmt.Sprintf("%s Possible values are: %s.", apischema.Docstring(payloadName, propertyName), strings.Join(roles, ", "))
+}
+
+func (r *IncidentAPIKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+	r.tokens = client.apiKeyTokens
+}
+
+// ModifyPlan marks token_last_issued_at as changing when a rotation is planned, so that
+// anything reading the new token waits for the rotation in the apply.
+func (r *IncidentAPIKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *IncidentAPIKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !apiKeyRotationPlanned(state, plan) {
+		return
+	}
+
+	plan.TokenLastIssuedAt = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// apiKeyRotationPlanned is true when rotation_trigger changes to a new value, including
+// when it's unknown until apply. Removing the trigger leaves the key as it is.
+func apiKeyRotationPlanned(state, plan *IncidentAPIKeyResourceModel) bool {
+	if plan.RotationTrigger.IsUnknown() {
+		return true
+	}
+
+	return !plan.RotationTrigger.IsNull() && !plan.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+func (r *IncidentAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentAPIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	result := r.create(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The token is kept out of state, and only handed to incident_api_key_token.
+	r.tokens.set(result.ApiKey.Id, result.Token)
+	tflog.Trace(ctx, fmt.Sprintf("created an API key resource with id=%s", result.ApiKey.Id))
+	data = r.buildModel(result.ApiKey, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+// create creates an API key from the config, returning it with its token. The
+// incident_api_key ephemeral resource creates its keys this way too.
+func (r *IncidentAPIKeyResource) create(ctx context.Context, data *IncidentAPIKeyResourceModel, diags *diag.Diagnostics) *client.APIKeysCreateResultV1 {
+	roleNames, teamIDs, teamRoleNames := r.buildRoles(ctx, data, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	result, err := r.client.APIKeysV1CreateWithResponse(ctx, client.APIKeysV1CreateJSONRequestBody{
+		Name:     data.Name.ValueString(),
+		Comments: data.Comments.ValueStringPointer(),
+		RoleNames: lo.Map(roleNames, func(name string, _ int) client.APIKeysCreatePayloadV1RoleNames {
+			return client.APIKeysCreatePayloadV1RoleNames(name)
+		}),
+		TeamIds: teamIDs,
+		TeamRoleNames: lo.Map(teamRoleNames, func(name string, _ int) client.APIKeysCreatePayloadV1TeamRoleNames {
+			return client.APIKeysCreatePayloadV1TeamRoleNames(name)
+		}),
+	})
+	if err != nil {
+		addAPIError(ctx, diags, nil, "Client Error", fmt.Sprintf("Unable to create API key '%s'", data.Name.ValueString()), err)
+		return nil
+	}
+
+	return result.JSON201
+}
+
+func (r *IncidentAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentAPIKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.APIKeysV1ShowWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		httpErr := client.HTTPError{}
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			tflog.Warn(ctx, fmt.Sprintf("API key with ID %s not found: removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read API key", err)
+		return
+	}
+
+	data = r.buildModel(result.JSON200.ApiKey, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *IncidentAPIKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	roleNames, teamIDs, teamRoleNames := r.buildRoles(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := r.client.APIKeysV1UpdateWithResponse(ctx, data.ID.ValueString(), client.APIKeysV1UpdateJSONRequestBody{
+		Name:     data.Name.ValueString(),
+		Comments: data.Comments.ValueStringPointer(),
+		RoleNames: lo.Map(roleNames, func(name string, _ int) client.APIKeysUpdatePayloadV1RoleNames {
+			return client.APIKeysUpdatePayloadV1RoleNames(name)
+		}),
+		TeamIds: teamIDs,
+		TeamRoleNames: lo.Map(teamRoleNames, func(name string, _ int) client.APIKeysUpdatePayloadV1TeamRoleNames {
+			return client.APIKeysUpdatePayloadV1TeamRoleNames(name)
+		}),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update API key", err)
+		return
+	}
+	apiKey := result.JSON200.ApiKey
+
+	if apiKeyRotationPlanned(state, data) {
+		gracePeriodMinutes := int64(apiKeyDefaultGracePeriodMinutes)
+		if !data.GracePeriodMinutes.IsNull() {
+			gracePeriodMinutes = data.GracePeriodMinutes.ValueInt64()
+		}
+
+		rotated, err := r.client.APIKeysV1RotateWithResponse(ctx, data.ID.ValueString(), client.APIKeysV1RotateJSONRequestBody{
+			GracePeriodMinutes: gracePeriodMinutes,
+		})
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to rotate API key", err)
+			return
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("rotated API key with id=%s", rotated.JSON201.ApiKey.Id))
+		r.tokens.set(rotated.JSON201.ApiKey.Id, rotated.JSON201.Token)
+		apiKey = rotated.JSON201.ApiKey
+	}
+
+	data = r.buildModel(apiKey, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentAPIKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.client.APIKeysV1DeleteWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete API key", err)
+		return
+	}
+}
+
+func (r *IncidentAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentAPIKeyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// buildRoles reads the role sets from the plan. The API wants every list present, so a
+// set left out of the config is sent as empty.
+func (r *IncidentAPIKeyResource) buildRoles(ctx context.Context, data *IncidentAPIKeyResourceModel, diags *diag.Diagnostics) (roleNames, teamIDs, teamRoleNames []string) {
+	roleNames, teamIDs, teamRoleNames = []string{}, []string{}, []string{}
+	if !data.RoleNames.IsNull() && !data.RoleNames.IsUnknown() {
+		diags.Append(data.RoleNames.ElementsAs(ctx, &roleNames, false)...)
+	}
+	if !data.TeamIDs.IsNull() && !data.TeamIDs.IsUnknown() {
+		diags.Append(data.TeamIDs.ElementsAs(ctx, &teamIDs, false)...)
+	}
+	if !data.TeamRoleNames.IsNull() && !data.TeamRoleNames.IsUnknown() {
+		diags.Append(data.TeamRoleNames.ElementsAs(ctx, &teamRoleNames, false)...)
+	}
+
+	return roleNames, teamIDs, teamRoleNames
+}
+
+func (r *IncidentAPIKeyResource) buildModel(apiKey client.APIKeyV1, prior *IncidentAPIKeyResourceModel) *IncidentAPIKeyResourceModel {
+	model := &IncidentAPIKeyResourceModel{
+		ID:                 types.StringValue(apiKey.Id),
+		Name:               types.StringValue(apiKey.Name),
+		Comments:           types.StringPointerValue(apiKey.Comments),
+		RotationTrigger:    types.StringNull(),
+		GracePeriodMinutes: types.Int64Null(),
+		TokenLastIssuedAt:  types.StringValue(apiKey.TokenLastIssuedAt.Format(time.RFC3339)),
+	}
+
+	// role_names is required, so it's always read back as a set, however empty.
+	priorRoleNames := types.SetValueMust(types.StringType, []attr.Value{})
+	priorTeamIDs, priorTeamRoleNames := types.SetNull(types.StringType), types.SetNull(types.StringType)
+	if prior != nil {
+		priorTeamIDs, priorTeamRoleNames = prior.TeamIDs, prior.TeamRoleNames
+		model.RotationTrigger = prior.RotationTrigger
+		model.GracePeriodMinutes = prior.GracePeriodMinutes
+		model.Timeouts = prior.Timeouts
+	}
+	model.RoleNames = apiKeyNamesToSet(lo.Map(apiKey.Roles, func(role client.APIKeyRoleV1, _ int) string {
+		return string(role.Name)
+	}), priorRoleNames)
+	model.TeamIDs = apiKeyNamesToSet(apiKey.TeamIds, priorTeamIDs)
+	model.TeamRoleNames = apiKeyNamesToSet(lo.Map(apiKey.TeamRoles, func(role client.APIKeyTeamRoleV1, _ int) string {
+		return string(role.Name)
+	}), priorTeamRoleNames)
+
+	return model
+}
+
+// apiKeyNamesToSet converts names the API returns into a set. The API returns an empty
+// list for a key with no team scope, which stays null when the config left it out.
+func apiKeyNamesToSet(names []string, prior types.Set) types.Set {
+	if len(names) == 0 && prior.IsNull() {
+		return types.SetNull(types.StringType)
+	}
+
+	elements := make([]attr.Value, len(names))
+	for i, name := range names {
+		elements[i] = types.StringValue(name)
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
@@ -0,0 +1,291 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccIncidentAPIKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentAPIKeyResourceConfig("CI key", []string{"viewer"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_api_key.example", "name", StableSuffix("CI key")),
+					resource.TestCheckResourceAttr(
+						"incident_api_key.example", "role_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr(
+						"incident_api_key.example", "role_names.*", "viewer"),
+					resource.TestCheckNoResourceAttr(
+						"incident_api_key.example", "team_ids"),
+					resource.TestCheckResourceAttrSet(
+						"incident_api_key.example", "token_last_issued_at"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_api_key.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// An imported key can't tell a null team scope from an empty one.
+				ImportStateVerifyIgnore: []string{"team_ids", "team_role_names"},
+			},
+			// Update roles
+			{
+				Config: testAccIncidentAPIKeyResourceConfig("CI key", []string{"viewer", "catalog_editor"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_api_key.example", "role_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr(
+						"incident_api_key.example", "role_names.*", "catalog_editor"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIncidentAPIKeyTokenEphemeralResource(t *testing.T) {
+	var issuedAt string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"incident": testAccProtoV6ProviderFactories["incident"],
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			// The key is created in this apply, so its token is handed out.
+			{
+				Config: testAccIncidentAPIKeyTokenConfig("1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.token", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+				},
+				Check: resource.TestCheckResourceAttrWith("incident_api_key.example", "token_last_issued_at", func(value string) error {
+					issuedAt = value
+					return nil
+				}),
+			},
+			// Changing the trigger rotates the key.
+			{
+				Config: testAccIncidentAPIKeyTokenConfig("2"),
+				Check: resource.TestCheckResourceAttrWith("incident_api_key.example", "token_last_issued_at", func(value string) error {
+					if value == issuedAt {
+						return fmt.Errorf("expected the token to be reissued, but it was last issued at %s", value)
+					}
+					return nil
+				}),
+			},
+		},
+	})
+}
+
+func testAccIncidentAPIKeyTokenConfig(rotationTrigger string) string {
+	return testRunTemplate("incident_api_key_token", `
+resource "incident_api_key" "example" {
+  name                 = {{ stableSuffix "CI key" | quote }}
+  comments             = "Created by the Terraform provider's acceptance tests"
+  role_names           = ["viewer"]
+  rotation_trigger     = {{ quote .RotationTrigger }}
+  grace_period_minutes = 5
+}
+
+ephemeral "incident_api_key_token" "example" {
+  api_key_id           = incident_api_key.example.id
+  token_last_issued_at = incident_api_key.example.token_last_issued_at
+}
+
+provider "echo" {
+  data = ephemeral.incident_api_key_token.example
+}
+
+resource "echo" "token" {}
+`, struct{ RotationTrigger string }{RotationTrigger: rotationTrigger})
+}
+
+// TestIncidentAPIKeyTokenEphemeralResourceOpen checks opening the ephemeral resource, as
+// every plan does, never touches the key.
+func TestIncidentAPIKeyTokenEphemeralResourceOpen(t *testing.T) {
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+	providerServer, diags := testProviderServer(t, api, nil)
+	if len(diags) > 0 {
+		t.Fatalf("configuring the provider: %+v", diags)
+	}
+
+	tokenType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"api_key_id":           tftypes.String,
+		"token_last_issued_at": tftypes.String,
+		"token":                tftypes.String,
+	}}
+	config, err := tfprotov6.NewDynamicValue(tokenType, tftypes.NewValue(tokenType, map[string]tftypes.Value{
+		"api_key_id":           tftypes.NewValue(tftypes.String, "01KEY"),
+		"token_last_issued_at": tftypes.NewValue(tftypes.String, "2025-01-01T00:00:00Z"),
+		"token":                tftypes.NewValue(tftypes.String, nil),
+	}))
+	if err != nil {
+		t.Fatalf("building the config: %v", err)
+	}
+
+	resp, err := providerServer.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+		TypeName: "incident_api_key_token",
+		Config:   &config,
+	})
+	if err != nil {
+		t.Fatalf("opening the ephemeral resource: %v", err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("opening the ephemeral resource: %+v", resp.Diagnostics)
+	}
+
+	result, err := resp.Result.Unmarshal(tokenType)
+	if err != nil {
+		t.Fatalf("unmarshalling the result: %v", err)
+	}
+	var attrs map[string]tftypes.Value
+	if err := result.As(&attrs); err != nil {
+		t.Fatalf("reading the result: %v", err)
+	}
+	assert.True(t, attrs["token"].IsNull(), "expected no token for a key that wasn't issued one in this run")
+}
+
+func TestAPIKeyRotationPlanned(t *testing.T) {
+	cases := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  bool
+	}{
+		{name: "both null", state: types.StringNull(), plan: types.StringNull(), want: false},
+		{name: "unchanged", state: types.StringValue("1"), plan: types.StringValue("1"), want: false},
+		{name: "changed", state: types.StringValue("1"), plan: types.StringValue("2"), want: true},
+		{name: "unknown until apply", state: types.StringValue("1"), plan: types.StringUnknown(), want: true},
+		{name: "set for the first time", state: types.StringNull(), plan: types.StringValue("1"), want: true},
+		{name: "removed", state: types.StringValue("1"), plan: types.StringNull(), want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := apiKeyRotationPlanned(
+				&IncidentAPIKeyResourceModel{RotationTrigger: tc.state},
+				&IncidentAPIKeyResourceModel{RotationTrigger: tc.plan},
+			)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestAccIncidentAPIKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"incident": testAccProtoV6ProviderFactories["incident"],
+			"echo":     echoprovider.NewProviderServer(),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRunTemplate("incident_api_key_ephemeral", `
+ephemeral "incident_api_key" "example" {
+  name       = {{ stableSuffix "Ephemeral key" | quote }}
+  comments   = "Created by the Terraform provider's acceptance tests"
+  role_names = ["viewer"]
+}
+
+provider "echo" {
+  data = ephemeral.incident_api_key.example
+}
+
+resource "echo" "key" {}
+`, nil),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.key", tfjsonpath.New("data").AtMapKey("id"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.key", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.key", tfjsonpath.New("data").AtMapKey("role_names"), knownvalue.SetExact([]knownvalue.Check{
+						knownvalue.StringExact("viewer"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func testAccIncidentAPIKeyResourceConfig(name string, roleNames []string) string {
+	return testRunTemplate("incident_api_key", `
+resource "incident_api_key" "example" {
+  name       = {{ stableSuffix .Name | quote }}
+  comments   = "Created by the Terraform provider's acceptance tests"
+  role_names = {{ toJson .RoleNames }}
+}
+`, struct {
+		Name      string
+		RoleNames []string
+	}{
+		Name:      name,
+		RoleNames: roleNames,
+	})
+}
+
+func TestAPIKeyNamesToSet(t *testing.T) {
+	empty := types.SetValueMust(types.StringType, []attr.Value{})
+	null := types.SetNull(types.StringType)
+
+	cases := []struct {
+		name  string
+		names []string
+		prior types.Set
+		want  types.Set
+	}{
+		{
+			name:  "none returned, none configured",
+			names: []string{},
+			prior: null,
+			want:  null,
+		},
+		{
+			name:  "none returned, configured empty",
+			names: []string{},
+			prior: empty,
+			want:  empty,
+		},
+		{
+			name:  "returned without being configured",
+			names: []string{"01G0J1EXE7AXZ2C93K61WBPYEH"},
+			prior: null,
+			want:  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("01G0J1EXE7AXZ2C93K61WBPYEH")}),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := apiKeyNamesToSet(tc.names, tc.prior)
+			if !got.Equal(tc.want) {
+				t.Errorf("apiKeyNamesToSet = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
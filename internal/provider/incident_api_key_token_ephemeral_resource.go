@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &IncidentAPIKeyTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &IncidentAPIKeyTokenEphemeralResource{}
+)
+
+// issuedAPIKeyTokens holds the tokens of the API keys incident_api_key created or rotated
+// while this provider has been running, by key ID. Terraform runs one provider for a whole
+// apply, so incident_api_key_token can hand out a token issued earlier in the same apply,
+// without it going anywhere near state.
+type issuedAPIKeyTokens struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func newIssuedAPIKeyTokens() *issuedAPIKeyTokens {
+	return &issuedAPIKeyTokens{tokens: map[string]string{}}
+}
+
+func (t *issuedAPIKeyTokens) set(apiKeyID, token string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tokens[apiKeyID] = token
+}
+
+func (t *issuedAPIKeyTokens) get(apiKeyID string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	token, ok := t.tokens[apiKeyID]
+
+	return token, ok
+}
+
+type IncidentAPIKeyTokenEphemeralResource struct {
+	tokens *issuedAPIKeyTokens
+}
+
+type IncidentAPIKeyTokenEphemeralResourceModel struct {
+	APIKeyID          types.String `tfsdk:"api_key_id"`
+	TokenLastIssuedAt types.String `tfsdk:"token_last_issued_at"`
+	Token             types.String `tfsdk:"token"`
+}
+
+func NewIncidentAPIKeyTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &IncidentAPIKeyTokenEphemeralResource{}
+}
+
+func (r *IncidentAPIKeyTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key_token"
+}
+
+func (r *IncidentAPIKeyTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the token an ` + "`incident_api_key`" + ` was issued in this apply, without it
+ever being stored in state or plan files.
+
+The API only hands out a key's token when the key is created or rotated, which
+` + "`incident_api_key`" + ` does on apply. Pass its ` + "`token_last_issued_at`" + ` here, so that
+Terraform reads the token after the key is created or rotated. In any other run, there's
+no new token to hand out, and ` + "`token`" + ` is null. Opening this never changes the key.
+
+Ephemeral resources need Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"api_key_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the API key, such as `incident_api_key.example.id`.",
+				Required:            true,
+			},
+			"token_last_issued_at": schema.StringAttribute{
+				MarkdownDescription: "When the key's token was last issued, such as `incident_api_key.example.token_last_issued_at`. It's unknown until the apply that creates or rotates the key, which makes Terraform wait for that before reading the token.",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("APIKeysRotateResultV1", "token") + " Null unless the key was created or rotated in this apply.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *IncidentAPIKeyTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.tokens = client.apiKeyTokens
+}
+
+func (r *IncidentAPIKeyTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data IncidentAPIKeyTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Token = types.StringNull()
+	if token, ok := r.tokens.get(data.APIKeyID.ValueString()); ok {
+		data.Token = types.StringValue(token)
+	} else {
+		tflog.Info(ctx, fmt.Sprintf("API key with id=%s wasn't issued a token in this run", data.APIKeyID.ValueString()))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
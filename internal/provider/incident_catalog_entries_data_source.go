@@ -83,7 +83,7 @@ func (d *IncidentCatalogEntriesDataSource) Read(ctx context.Context, req datasou
 	for {
 		result, err := d.client.CatalogV3ListEntriesWithResponse(ctx, params)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entries, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list catalog entries", err)
 			return
 		}
 
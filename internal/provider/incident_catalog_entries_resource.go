@@ -6,7 +6,9 @@ import (
 	"reflect"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pkg/errors"
@@ -28,6 +31,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentCatalogEntriesResource{}
 	_ resource.ResourceWithImportState = &IncidentCatalogEntriesResource{}
+	_ resource.ResourceWithIdentity    = &IncidentCatalogEntriesResource{}
 )
 
 type IncidentCatalogEntriesResource struct {
@@ -41,6 +45,7 @@ type IncidentCatalogEntriesResourceModel struct {
 
 	// This caches a lookup of the managed attributes set
 	managedAttrSet map[string]bool
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
 }
 
 type CatalogEntryModel struct {
@@ -163,6 +168,9 @@ This can be used to allow other attributes of a catalog entry to be managed else
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -191,14 +199,18 @@ func (r *IncidentCatalogEntriesResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	catalogType, entries, err := r.reconcile(ctx, data)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 
 	data = r.buildModel(*catalogType, entries, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setCatalogEntriesIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -208,14 +220,18 @@ func (r *IncidentCatalogEntriesResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	catalogType, entries, err := r.getEntries(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list entries, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list entries", err)
 		return
 	}
 
 	data = r.buildModel(*catalogType, entries, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setCatalogEntriesIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -225,14 +241,18 @@ func (r *IncidentCatalogEntriesResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	catalogType, entries, err := r.reconcile(ctx, data)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 
 	data = r.buildModel(*catalogType, entries, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setCatalogEntriesIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -242,12 +262,15 @@ func (r *IncidentCatalogEntriesResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// Set entries to an empty list.
 	data.Entries = map[string]CatalogEntryModel{}
 
 	catalogType, entries, err := r.reconcile(ctx, data)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 	if len(entries) > 0 {
@@ -257,7 +280,23 @@ func (r *IncidentCatalogEntriesResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *IncidentCatalogEntriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("catalog_type_id"), req, resp)
+}
+
+// IdentitySchema identifies the resource by its catalog type, as there's only one per
+// type. Its ID is the catalog type's ID.
+func (r *IncidentCatalogEntriesResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"catalog_type_id": "The ID of the catalog type whose entries are managed.",
+	})
+}
+
+func setCatalogEntriesIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, catalogTypeID types.String, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("catalog_type_id"), catalogTypeID)...)
 }
 
 // buildModel generates a terraform model from a catalog type and current list of all
@@ -346,6 +385,7 @@ func (r *IncidentCatalogEntriesResource) buildModel(catalogType client.CatalogTy
 		ID:                types.StringValue(catalogType.Id),
 		Entries:           modelEntries,
 		ManagedAttributes: plan.ManagedAttributes,
+		Timeouts:          plan.Timeouts,
 	}
 }
 
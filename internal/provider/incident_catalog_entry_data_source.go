@@ -138,7 +138,7 @@ func (i *IncidentCatalogEntryDataSource) Read(ctx context.Context, req datasourc
 		PageSize:      1, // We only need one result since we're searching by identifier
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find catalog entry, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to find catalog entry", err)
 		return
 	}
 
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -25,6 +26,7 @@ import (
 var (
 	_ resource.Resource                   = &IncidentCatalogEntryResource{}
 	_ resource.ResourceWithImportState    = &IncidentCatalogEntryResource{}
+	_ resource.ResourceWithIdentity       = &IncidentCatalogEntryResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentCatalogEntryResource{}
 )
 
@@ -41,6 +43,7 @@ type IncidentCatalogEntryResourceModel struct {
 	Rank              types.Int64                  `tfsdk:"rank"`
 	AttributeValues   []CatalogEntryAttributeValue `tfsdk:"attribute_values"`
 	ManagedAttributes types.Set                    `tfsdk:"managed_attributes"`
+	Timeouts          timeouts.Value               `tfsdk:"timeouts"`
 }
 
 func (m IncidentCatalogEntryResourceModel) buildAttributeValues(ctx context.Context) map[string]client.CatalogEngineParamBindingPayloadV3 {
@@ -196,6 +199,9 @@ When ` + "`managed_attributes`" + ` is set, destroying the Terraform resource wi
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -224,6 +230,9 @@ func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var rank *int32
 	if !data.Rank.IsNull() {
 		rank = lo.ToPtr(int32(data.Rank.ValueInt64()))
@@ -250,13 +259,14 @@ func (r *IncidentCatalogEntryResource) Create(ctx context.Context, req resource.
 		AttributeValues: data.buildAttributeValues(ctx),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog entry, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create catalog entry", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a catalog entry resource with id=%s", result.JSON201.CatalogEntry.Id))
 	data = r.buildModel(result.JSON201.CatalogEntry, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -266,6 +276,9 @@ func (r *IncidentCatalogEntryResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CatalogV3ShowEntryWithResponse(ctx, data.ID.ValueString(), nil)
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -275,12 +288,13 @@ func (r *IncidentCatalogEntryResource) Read(ctx context.Context, req resource.Re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog entry, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog entry", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.CatalogEntry, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -290,6 +304,9 @@ func (r *IncidentCatalogEntryResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var rank *int32
 	if !data.Rank.IsNull() {
 		rank = lo.ToPtr(int32(data.Rank.ValueInt64()))
@@ -327,12 +344,13 @@ func (r *IncidentCatalogEntryResource) Update(ctx context.Context, req resource.
 		UpdateAttributes: updateAttributes,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog entry, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update catalog entry", err)
 		return
 	}
 
 	updatedModel := r.buildModel(result.JSON200.CatalogEntry, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &updatedModel)...)
+	setIDIdentity(ctx, resp.Identity, updatedModel.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogEntryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -342,6 +360,9 @@ func (r *IncidentCatalogEntryResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	// If managed_attributes is set, we're only managing specific attributes on an entry
 	// that may be owned elsewhere. Instead of deleting the entry, clear the managed
 	// attributes by sending an update with empty values.
@@ -363,7 +384,7 @@ func (r *IncidentCatalogEntryResource) Delete(ctx context.Context, req resource.
 				UpdateAttributes: &managedAttributeIDs,
 			})
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear managed attributes on catalog entry, got error: %s", err))
+				addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to clear managed attributes on catalog entry", err)
 				return
 			}
 
@@ -380,13 +401,17 @@ func (r *IncidentCatalogEntryResource) Delete(ctx context.Context, req resource.
 	// functional change that wants its own PR and acceptance run, not a codegen bump.
 	_, err := r.client.CatalogV2DestroyEntry(ctx, data.ID.ValueString()) //nolint:staticcheck // migrate to CatalogV3DestroyEntry separately.
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog entry, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete catalog entry", err)
 		return
 	}
 }
 
 func (r *IncidentCatalogEntryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentCatalogEntryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentCatalogEntryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
@@ -535,5 +560,6 @@ func (r *IncidentCatalogEntryResource) buildModel(entry client.CatalogEntryV3, d
 		AttributeValues: values,
 		// These are managed in config only
 		ManagedAttributes: data.ManagedAttributes,
+		Timeouts:          data.Timeouts,
 	}
 }
@@ -112,7 +112,7 @@ func (i *IncidentCatalogTypeAttributeDataSource) Read(ctx context.Context, req d
 
 	result, err := i.client.CatalogV3ShowTypeWithResponse(ctx, data.CatalogTypeID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog type", err)
 		return
 	}
 
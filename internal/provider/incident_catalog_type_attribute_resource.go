@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,6 +28,7 @@ var (
 	_ resource.ResourceWithConfigure      = &IncidentCatalogTypeAttributeResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentCatalogTypeAttributeResource{}
 	_ resource.ResourceWithImportState    = &IncidentCatalogTypeAttributeResource{}
+	_ resource.ResourceWithIdentity       = &IncidentCatalogTypeAttributeResource{}
 )
 
 // isSchemaOnlyMode returns true if the mode indicates that Terraform manages only
@@ -52,14 +54,15 @@ type IncidentCatalogTypeAttributeResource struct {
 }
 
 type IncidentCatalogTypeAttributesResourceModel struct {
-	ID                types.String `tfsdk:"id"`
-	CatalogTypeID     types.String `tfsdk:"catalog_type_id"`
-	Name              types.String `tfsdk:"name"`
-	Type              types.String `tfsdk:"type"`
-	Array             types.Bool   `tfsdk:"array"`
-	BacklinkAttribute types.String `tfsdk:"backlink_attribute"`
-	Path              types.List   `tfsdk:"path"`
-	SchemaOnly        types.Bool   `tfsdk:"schema_only"`
+	ID                types.String   `tfsdk:"id"`
+	CatalogTypeID     types.String   `tfsdk:"catalog_type_id"`
+	Name              types.String   `tfsdk:"name"`
+	Type              types.String   `tfsdk:"type"`
+	Array             types.Bool     `tfsdk:"array"`
+	BacklinkAttribute types.String   `tfsdk:"backlink_attribute"`
+	Path              types.List     `tfsdk:"path"`
+	SchemaOnly        types.Bool     `tfsdk:"schema_only"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (m IncidentCatalogTypeAttributesResourceModel) buildAttribute(ctx context.Context) client.CatalogTypeAttributePayloadV3 {
@@ -197,6 +200,9 @@ NOTE: When enabled, you should use the ` + "`managed_attributes`" + ` argument o
 				Computed: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -248,6 +254,9 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var result *client.CatalogV3UpdateTypeSchemaResponse
 	err := r.lockFor(ctx, data.CatalogTypeID.ValueString(), func(ctx context.Context, catalogType client.CatalogTypeV3) error {
 		attributes := []client.CatalogTypeAttributePayloadV3{}
@@ -270,7 +279,7 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 
@@ -286,12 +295,14 @@ func (r *IncidentCatalogTypeAttributeResource) Create(ctx context.Context, req r
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Updated catalog type schema for id=%s", result.JSON200.CatalogType.Id))
-	data, found := r.buildModel(result.JSON200.CatalogType, attributeID)
+	model, found := r.buildModel(result.JSON200.CatalogType, attributeID)
 	if !found {
 		resp.Diagnostics.AddError("Client Error", "Unable to find attribute in catalog type schema after creation")
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	model.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "catalog_type_id", "id")
 }
 
 func (r *IncidentCatalogTypeAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -301,6 +312,9 @@ func (r *IncidentCatalogTypeAttributeResource) Read(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CatalogV3ShowTypeWithResponse(ctx, data.CatalogTypeID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -310,17 +324,19 @@ func (r *IncidentCatalogTypeAttributeResource) Read(ctx context.Context, req res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog type", err)
 		return
 	}
 
-	data, found := r.buildModel(result.JSON200.CatalogType, data.ID.ValueString())
+	model, found := r.buildModel(result.JSON200.CatalogType, data.ID.ValueString())
 	if !found {
 		tflog.Warn(ctx, fmt.Sprintf("Attribute with ID %s not found in catalog type %s: removing from state.", data.ID.ValueString(), data.CatalogTypeID.ValueString()))
 		resp.State.RemoveResource(ctx)
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	model.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "catalog_type_id", "id")
 }
 
 func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -330,6 +346,9 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var (
 		alreadyExists bool
 	)
@@ -367,7 +386,7 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 
@@ -387,12 +406,14 @@ func (r *IncidentCatalogTypeAttributeResource) Update(ctx context.Context, req r
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("Updated catalog type schema for catalog type with id=%s", result.JSON200.CatalogType.Id))
-	data, found := r.buildModel(result.JSON200.CatalogType, attributeID)
+	model, found := r.buildModel(result.JSON200.CatalogType, attributeID)
 	if !found {
 		resp.Diagnostics.AddError("Client Error", "Unable to find attribute in catalog type schema after update")
 		return
 	}
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	model.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "catalog_type_id", "id")
 }
 
 func (r *IncidentCatalogTypeAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -402,6 +423,9 @@ func (r *IncidentCatalogTypeAttributeResource) Delete(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	err := r.lockFor(ctx, data.CatalogTypeID.ValueString(), func(ctx context.Context, catalogType client.CatalogTypeV3) error {
 		attributes := []client.CatalogTypeAttributePayloadV3{}
 		for _, attribute := range catalogType.Schema.Attributes {
@@ -423,7 +447,7 @@ func (r *IncidentCatalogTypeAttributeResource) Delete(ctx context.Context, req r
 		return nil
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 		return
 	}
 }
@@ -498,7 +522,7 @@ func (r *IncidentCatalogTypeAttributeResource) lockFor(ctx context.Context, cata
 
 func (r *IncidentCatalogTypeAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// The import ID format is catalogTypeID:attributeID
-	idParts := strings.Split(req.ID, ":")
+	idParts := strings.Split(importID(ctx, req, ":", &resp.Diagnostics, "catalog_type_id", "id"), ":")
 	if len(idParts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
@@ -527,7 +551,7 @@ func (r *IncidentCatalogTypeAttributeResource) ImportState(ctx context.Context,
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog type", err)
 		return
 	}
 	if result.JSON200 == nil {
@@ -544,9 +568,17 @@ func (r *IncidentCatalogTypeAttributeResource) ImportState(ctx context.Context,
 		return
 	}
 
+	data.Timeouts = nullTimeouts(ctx)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *IncidentCatalogTypeAttributeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"catalog_type_id": "The ID of the catalog type the attribute belongs to.",
+		"id":              "The ID of the attribute.",
+	})
+}
+
 func (*IncidentCatalogTypeAttributeResource) attributeToPayload(attribute client.CatalogTypeAttributeV3) client.CatalogTypeAttributePayloadV3 {
 	var path *[]client.CatalogTypeAttributePathItemPayloadV3
 	if attribute.Path != nil {
@@ -101,7 +101,7 @@ func (i *IncidentCatalogTypeDataSource) Read(ctx context.Context, req datasource
 
 	result, err := i.client.CatalogV3ListTypesWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog types, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog types", err)
 		return
 	}
 
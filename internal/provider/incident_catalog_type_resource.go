@@ -6,8 +6,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentCatalogTypeResource{}
 	_ resource.ResourceWithImportState = &IncidentCatalogTypeResource{}
+	_ resource.ResourceWithIdentity    = &IncidentCatalogTypeResource{}
 )
 
 type IncidentCatalogTypeResource struct {
@@ -32,20 +34,25 @@ type IncidentCatalogTypeResource struct {
 }
 
 type IncidentCatalogTypeResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	TypeName            types.String `tfsdk:"type_name"`
-	Description         types.String `tfsdk:"description"`
-	SourceRepoURL       types.String `tfsdk:"source_repo_url"`
-	Categories          types.List   `tfsdk:"categories"`
-	UseNameAsIdentifier types.Bool   `tfsdk:"use_name_as_identifier"`
-	OwningTeamIDs       types.Set    `tfsdk:"owning_team_ids"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	TypeName            types.String   `tfsdk:"type_name"`
+	Description         types.String   `tfsdk:"description"`
+	SourceRepoURL       types.String   `tfsdk:"source_repo_url"`
+	Categories          types.List     `tfsdk:"categories"`
+	UseNameAsIdentifier types.Bool     `tfsdk:"use_name_as_identifier"`
+	OwningTeamIDs       types.Set      `tfsdk:"owning_team_ids"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentCatalogTypeResource() resource.Resource {
 	return &IncidentCatalogTypeResource{}
 }
 
+func NewIncidentCatalogTypeListResource() list.ListResource {
+	return newIDListResource(NewIncidentCatalogTypeResource, listCatalogTypeResources)
+}
+
 func (r *IncidentCatalogTypeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_catalog_type"
 }
@@ -112,6 +119,9 @@ func (r *IncidentCatalogTypeResource) Schema(ctx context.Context, req resource.S
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -141,6 +151,9 @@ func (r *IncidentCatalogTypeResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	requestBody := client.CatalogCreateTypePayloadV3{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
@@ -182,13 +195,14 @@ func (r *IncidentCatalogTypeResource) Create(ctx context.Context, req resource.C
 
 	result, err := r.client.CatalogV3CreateTypeWithResponse(ctx, requestBody)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create catalog type", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a catalog type resource with id=%s", result.JSON201.CatalogType.Id))
 	data = r.buildModel(result.JSON201.CatalogType, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogTypeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -198,6 +212,9 @@ func (r *IncidentCatalogTypeResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CatalogV3ShowTypeWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -207,12 +224,13 @@ func (r *IncidentCatalogTypeResource) Read(ctx context.Context, req resource.Rea
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read catalog type", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.CatalogType, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogTypeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -222,6 +240,9 @@ func (r *IncidentCatalogTypeResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	requestBody := client.CatalogV3UpdateTypeJSONRequestBody{
 		Name: data.Name.ValueString(),
 		// TypeName cannot be changed once set
@@ -262,12 +283,13 @@ func (r *IncidentCatalogTypeResource) Update(ctx context.Context, req resource.U
 
 	result, err := r.client.CatalogV3UpdateTypeWithResponse(ctx, data.ID.ValueString(), requestBody)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update catalog type", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.CatalogType, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCatalogTypeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -277,15 +299,22 @@ func (r *IncidentCatalogTypeResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.CatalogV3DestroyTypeWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete catalog type, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete catalog type", err)
 		return
 	}
 }
 
 func (r *IncidentCatalogTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentCatalogTypeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentCatalogTypeResource) buildModel(catalogType client.CatalogTypeV3, prior *IncidentCatalogTypeResourceModel) *IncidentCatalogTypeResourceModel {
@@ -315,6 +344,10 @@ func (r *IncidentCatalogTypeResource) buildModel(catalogType client.CatalogTypeV
 		model.OwningTeamIDs = types.SetNull(types.StringType)
 	}
 
+	if prior != nil {
+		model.Timeouts = prior.Timeouts
+	}
+
 	return model
 }
 
@@ -331,3 +364,21 @@ func owningTeamIDsToSet(ids *[]string) types.Set {
 
 	return types.SetValueMust(types.StringType, elements)
 }
+
+// listCatalogTypeResources lists all catalog types for `terraform query`.
+func listCatalogTypeResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.CatalogV3ListTypesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing catalog types: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.CatalogTypes {
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
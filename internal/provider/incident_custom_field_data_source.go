@@ -106,7 +106,7 @@ func (i *IncidentCustomFieldDataSource) Read(ctx context.Context, req datasource
 
 	result, err := i.client.CustomFieldsV2ListWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom fields, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read custom fields", err)
 		return
 	}
 
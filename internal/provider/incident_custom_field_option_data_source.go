@@ -91,7 +91,7 @@ func (i *IncidentCustomFieldOptionDataSource) Read(ctx context.Context, req data
 		CustomFieldId: data.CustomFieldID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field options, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read custom field options", err)
 		return
 	}
 
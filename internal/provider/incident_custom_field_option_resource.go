@@ -5,7 +5,7 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentCustomFieldOptionResource{}
 	_ resource.ResourceWithImportState = &IncidentCustomFieldOptionResource{}
+	_ resource.ResourceWithIdentity    = &IncidentCustomFieldOptionResource{}
 )
 
 type IncidentCustomFieldOptionResource struct {
@@ -28,10 +29,11 @@ type IncidentCustomFieldOptionResource struct {
 }
 
 type IncidentCustomFieldOptionResourceModel struct {
-	ID            types.String `tfsdk:"id"`
-	CustomFieldID types.String `tfsdk:"custom_field_id"`
-	SortKey       types.Int64  `tfsdk:"sort_key"`
-	Value         types.String `tfsdk:"value"`
+	ID            types.String   `tfsdk:"id"`
+	CustomFieldID types.String   `tfsdk:"custom_field_id"`
+	SortKey       types.Int64    `tfsdk:"sort_key"`
+	Value         types.String   `tfsdk:"value"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentCustomFieldOptionResource() resource.Resource {
@@ -70,6 +72,9 @@ func (r *IncidentCustomFieldOptionResource) Schema(ctx context.Context, req reso
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -98,6 +103,9 @@ func (r *IncidentCustomFieldOptionResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var sortKey *int64
 	if !data.SortKey.IsNull() {
 		sortKey = lo.ToPtr(data.SortKey.ValueInt64())
@@ -108,13 +116,16 @@ func (r *IncidentCustomFieldOptionResource) Create(ctx context.Context, req reso
 		Value:         data.Value.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field option, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create custom field option", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a custom field option resource with id=%s", result.JSON201.CustomFieldOption.Id))
-	data = r.buildModel(result.JSON201.CustomFieldOption)
+	model := r.buildModel(result.JSON201.CustomFieldOption)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -124,6 +135,9 @@ func (r *IncidentCustomFieldOptionResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CustomFieldOptionsV1ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -133,12 +147,15 @@ func (r *IncidentCustomFieldOptionResource) Read(ctx context.Context, req resour
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field option, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read custom field option", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomFieldOption)
+	model := r.buildModel(result.JSON200.CustomFieldOption)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -148,17 +165,23 @@ func (r *IncidentCustomFieldOptionResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CustomFieldOptionsV1UpdateWithResponse(ctx, data.ID.ValueString(), client.CustomFieldOptionsV1UpdateJSONRequestBody{
 		SortKey: data.SortKey.ValueInt64(),
 		Value:   data.Value.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update custom field", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomFieldOption)
+	model := r.buildModel(result.JSON200.CustomFieldOption)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -168,15 +191,22 @@ func (r *IncidentCustomFieldOptionResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.CustomFieldOptionsV1DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field option, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete custom field option", err)
 		return
 	}
 }
 
 func (r *IncidentCustomFieldOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentCustomFieldOptionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentCustomFieldOptionResource) buildModel(option client.CustomFieldOptionV1) *IncidentCustomFieldOptionResourceModel {
@@ -5,7 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentCustomFieldResource{}
 	_ resource.ResourceWithImportState = &IncidentCustomFieldResource{}
+	_ resource.ResourceWithIdentity    = &IncidentCustomFieldResource{}
 )
 
 type IncidentCustomFieldResource struct {
@@ -35,6 +37,7 @@ type IncidentCustomFieldResourceModel struct {
 	FilterBy                   *IncidentCustomFieldFilterByOptionsModel `tfsdk:"filter_by"`
 	GroupByCatalogAttributeID  types.String                             `tfsdk:"group_by_catalog_attribute_id"`
 	HelptextCatalogAttributeID types.String                             `tfsdk:"helptext_catalog_attribute_id"`
+	Timeouts                   timeouts.Value                           `tfsdk:"timeouts"`
 }
 
 type IncidentCustomFieldFilterByOptionsModel struct {
@@ -46,6 +49,10 @@ func NewIncidentCustomFieldResource() resource.Resource {
 	return &IncidentCustomFieldResource{}
 }
 
+func NewIncidentCustomFieldListResource() list.ListResource {
+	return newIDListResource(NewIncidentCustomFieldResource, listCustomFieldResources)
+}
+
 func (r *IncidentCustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_custom_field"
 }
@@ -106,6 +113,9 @@ func (r *IncidentCustomFieldResource) Schema(ctx context.Context, req resource.S
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -134,6 +144,9 @@ func (r *IncidentCustomFieldResource) Create(ctx context.Context, req resource.C
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	payload := client.CustomFieldsV2CreateJSONRequestBody{
 		Name:                       data.Name.ValueString(),
 		Description:                data.Description.ValueString(),
@@ -152,13 +165,16 @@ func (r *IncidentCustomFieldResource) Create(ctx context.Context, req resource.C
 
 	result, err := r.client.CustomFieldsV2CreateWithResponse(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field '%s', got error: %s", data.Name.ValueString(), err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to create custom field '%s'", data.Name.ValueString()), err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a custom field resource with id=%s", result.JSON201.CustomField.Id))
-	data = r.buildModel(result.JSON201.CustomField)
+	model := r.buildModel(result.JSON201.CustomField)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -168,6 +184,9 @@ func (r *IncidentCustomFieldResource) Read(ctx context.Context, req resource.Rea
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.CustomFieldsV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -177,12 +196,15 @@ func (r *IncidentCustomFieldResource) Read(ctx context.Context, req resource.Rea
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read custom field", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomField)
+	model := r.buildModel(result.JSON200.CustomField)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -192,6 +214,9 @@ func (r *IncidentCustomFieldResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	payload := client.CustomFieldsV2UpdateJSONRequestBody{
 		Name:                       data.Name.ValueString(),
 		Description:                data.Description.ValueString(),
@@ -207,12 +232,15 @@ func (r *IncidentCustomFieldResource) Update(ctx context.Context, req resource.U
 
 	result, err := r.client.CustomFieldsV2UpdateWithResponse(ctx, data.ID.ValueString(), payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update custom field", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.CustomField)
+	model := r.buildModel(result.JSON200.CustomField)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentCustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -222,15 +250,22 @@ func (r *IncidentCustomFieldResource) Delete(ctx context.Context, req resource.D
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.CustomFieldsV2DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete custom field", err)
 		return
 	}
 }
 
 func (r *IncidentCustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentCustomFieldResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentCustomFieldResource) buildModel(cf client.CustomFieldV2) *IncidentCustomFieldResourceModel {
@@ -253,3 +288,21 @@ func (r *IncidentCustomFieldResource) buildModel(cf client.CustomFieldV2) *Incid
 
 	return res
 }
+
+// listCustomFieldResources lists all custom fields for `terraform query`.
+func listCustomFieldResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.CustomFieldsV2ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing custom fields: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.CustomFields {
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
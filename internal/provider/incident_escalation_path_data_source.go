@@ -422,7 +422,7 @@ func (d *IncidentEscalationPathDataSource) Read(ctx context.Context, req datasou
 		// Lookup by ID
 		result, err := d.client.EscalationsV2ShowPathWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation path, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read escalation path", err)
 			return
 		}
 		escalationPath = &result.JSON200.EscalationPath
@@ -433,7 +433,7 @@ func (d *IncidentEscalationPathDataSource) Read(ctx context.Context, req datasou
 		// Step 1: Get the cached EscalationPath catalog type ID
 		escalationPathTypeID, err := d.getEscalationPathTypeID(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 			return
 		}
 
@@ -447,7 +447,7 @@ func (d *IncidentEscalationPathDataSource) Read(ctx context.Context, req datasou
 			err = fmt.Errorf("%s", entriesResult.Body)
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entries, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list catalog entries", err)
 			return
 		}
 
@@ -466,7 +466,7 @@ func (d *IncidentEscalationPathDataSource) Read(ctx context.Context, req datasou
 		escalationPathID := *catalogEntry.ExternalId
 		result, err := d.client.EscalationsV2ShowPathWithResponse(ctx, escalationPathID)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation path with ID %s, got error: %s", escalationPathID, err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to read escalation path with ID %s", escalationPathID), err)
 			return
 		}
 		escalationPath = &result.JSON200.EscalationPath
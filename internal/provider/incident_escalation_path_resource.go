@@ -6,11 +6,12 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -30,6 +31,7 @@ var (
 	_ resource.Resource                   = &IncidentEscalationPathResource{}
 	_ resource.ResourceWithImportState    = &IncidentEscalationPathResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentEscalationPathResource{}
+	_ resource.ResourceWithIdentity       = &IncidentEscalationPathResource{}
 )
 
 type IncidentEscalationPathResource struct {
@@ -38,12 +40,13 @@ type IncidentEscalationPathResource struct {
 }
 
 type IncidentEscalationPathResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Path         types.List   `tfsdk:"path"`
-	WorkingHours types.List   `tfsdk:"working_hours"`
-	RepeatConfig types.Object `tfsdk:"repeat_config"`
-	TeamIDs      types.Set    `tfsdk:"team_ids"`
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Path         types.List     `tfsdk:"path"`
+	WorkingHours types.List     `tfsdk:"working_hours"`
+	RepeatConfig types.Object   `tfsdk:"repeat_config"`
+	TeamIDs      types.Set      `tfsdk:"team_ids"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type IncidentEscalationPathNode struct {
@@ -198,6 +201,10 @@ func NewIncidentEscalationPathResource() resource.Resource {
 	return &IncidentEscalationPathResource{}
 }
 
+func NewIncidentEscalationPathListResource() list.ListResource {
+	return newIDListResource(NewIncidentEscalationPathResource, listEscalationPathResources)
+}
+
 func (r *IncidentEscalationPathResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_escalation_path"
 }
@@ -252,6 +259,9 @@ func (r *IncidentEscalationPathResource) Schema(ctx context.Context, req resourc
 				ElementType:         types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -656,6 +666,9 @@ func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var workingHours *[]client.WeekdayIntervalConfigV2
 	if !data.WorkingHours.IsNull() && !data.WorkingHours.IsUnknown() {
 		var whModels []models.IncidentWeekdayIntervalConfig
@@ -708,7 +721,7 @@ func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resourc
 		RepeatConfig: repeatConfig,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create escalation path, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create escalation path", err)
 		return
 	}
 
@@ -723,8 +736,11 @@ func (r *IncidentEscalationPathResource) Create(ctx context.Context, req resourc
 	claimResource(ctx, r.client, result.JSON201.EscalationPath.Id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeEscalationPath, r.terraformVersion)
 
 	tflog.Trace(ctx, fmt.Sprintf("created an escalation path resource with id=%s", result.JSON201.EscalationPath.Id))
-	data = r.buildModel(ctx, result.JSON201.EscalationPath, &resp.Diagnostics)
+	model := r.buildModel(ctx, result.JSON201.EscalationPath, &resp.Diagnostics)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentEscalationPathResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -734,6 +750,9 @@ func (r *IncidentEscalationPathResource) Read(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.EscalationsV2ShowPathWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -743,7 +762,7 @@ func (r *IncidentEscalationPathResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read escalation path, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read escalation path", err)
 		return
 	}
 
@@ -755,8 +774,11 @@ func (r *IncidentEscalationPathResource) Read(ctx context.Context, req resource.
 		return
 	}
 
-	data = r.buildModel(ctx, result.JSON200.EscalationPath, &resp.Diagnostics)
+	model := r.buildModel(ctx, result.JSON200.EscalationPath, &resp.Diagnostics)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -766,6 +788,9 @@ func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var workingHours *[]client.WeekdayIntervalConfigV2
 	if !data.WorkingHours.IsNull() && !data.WorkingHours.IsUnknown() {
 		var whModels []models.IncidentWeekdayIntervalConfig
@@ -818,7 +843,7 @@ func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resourc
 		RepeatConfig: repeatConfig,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update escalation path, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update escalation path", err)
 		return
 	}
 
@@ -832,8 +857,11 @@ func (r *IncidentEscalationPathResource) Update(ctx context.Context, req resourc
 
 	claimResource(ctx, r.client, result.JSON200.EscalationPath.Id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeEscalationPath, r.terraformVersion)
 
-	data = r.buildModel(ctx, result.JSON200.EscalationPath, &resp.Diagnostics)
+	model := r.buildModel(ctx, result.JSON200.EscalationPath, &resp.Diagnostics)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentEscalationPathResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -843,16 +871,27 @@ func (r *IncidentEscalationPathResource) Delete(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.EscalationsV2DestroyPathWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete escalation path, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete escalation path", err)
 		return
 	}
 }
 
 func (r *IncidentEscalationPathResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeEscalationPath, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeEscalationPath, r.terraformVersion)
+}
+
+func (r *IncidentEscalationPathResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentEscalationPathResource) buildModel(ctx context.Context, ep client.EscalationPathV2, diags *diag.Diagnostics) *IncidentEscalationPathResourceModel {
@@ -1197,3 +1236,35 @@ func (r *IncidentEscalationPathResource) toPathPayload(ctx context.Context, path
 
 	return out
 }
+
+// listEscalationPathResources lists all escalation paths for `terraform query`, a page at a time.
+func listEscalationPathResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	var (
+		after  *string
+		listed = []listedResource{}
+	)
+
+	for {
+		result, err := apiClient.EscalationsV2ListPathsWithResponse(ctx, &client.EscalationsV2ListPathsParams{
+			PageSize: lo.ToPtr(int64(listResourcePageSize)),
+			After:    after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response listing escalation paths: %s", result.Status())
+		}
+
+		for _, item := range result.JSON200.EscalationPaths {
+			listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+		}
+
+		after = result.JSON200.PaginationMeta.After
+		if after == nil {
+			break
+		}
+	}
+
+	return listed, nil
+}
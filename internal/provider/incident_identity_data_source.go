@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentIdentityDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentIdentityDataSource{}
+)
+
+func NewIncidentIdentityDataSource() datasource.DataSource {
+	return &IncidentIdentityDataSource{}
+}
+
+type IncidentIdentityDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIdentityDataSourceModel struct {
+	OrganisationID types.String `tfsdk:"organisation_id"`
+	DashboardURL   types.String `tfsdk:"dashboard_url"`
+	APIKeyName     types.String `tfsdk:"api_key_name"`
+	Roles          types.Set    `tfsdk:"roles"`
+	TeamRoles      types.Set    `tfsdk:"team_roles"`
+	TeamIDs        types.Set    `tfsdk:"team_ids"`
+}
+
+func (d *IncidentIdentityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_identity"
+}
+
+func (d *IncidentIdentityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the organisation and API key this provider is configured with.
+
+Use it to label outputs with the organisation a configuration applies to, or to check that
+a provider alias points where you expect. To refuse to run at all against the wrong
+organisation, set the provider's ` + "`expected_organisation_id`" + ` instead.
+
+The API doesn't return an ID for the organisation, so ` + "`organisation_id`" + ` is the slug from
+its dashboard URL: ` + "`my-org`" + ` for ` + "`https://app.incident.io/my-org`" + `. It isn't an ID you'll find
+anywhere else in the API.`,
+		Attributes: map[string]schema.Attribute{
+			"organisation_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: organisationIDDescription,
+			},
+			"dashboard_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IdentityV1", "dashboard_url"),
+			},
+			"api_key_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IdentityV1", "name"),
+			},
+			"roles": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: apischema.Docstring("IdentityV1", "roles"),
+			},
+			"team_roles": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: apischema.Docstring("IdentityV1", "team_roles"),
+			},
+			"team_ids": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The IDs of the teams this API key is scoped to, which `team_roles` apply within.",
+			},
+		},
+	}
+}
+
+// organisationIDDescription describes how we identify an organisation: the identity API
+// doesn't return an ID, so we use the one in its dashboard URL.
+const organisationIDDescription = "The organisation's ID, as it appears in its dashboard URL: `my-org` for `https://app.incident.io/my-org`."
+
+func (d *IncidentIdentityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+func (d *IncidentIdentityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	result, err := d.client.UtilitiesV1IdentityWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read identity", err)
+		return
+	}
+	identity := result.JSON200.Identity
+
+	roles := []attr.Value{}
+	for _, role := range identity.Roles {
+		roles = append(roles, types.StringValue(string(role)))
+	}
+	teamRoles := []attr.Value{}
+	for _, role := range identity.TeamRoles {
+		teamRoles = append(teamRoles, types.StringValue(string(role)))
+	}
+	teamIDs := []attr.Value{}
+	for _, team := range identity.Teams {
+		teamIDs = append(teamIDs, types.StringValue(team.Id))
+	}
+
+	data := IncidentIdentityDataSourceModel{
+		OrganisationID: types.StringValue(organisationID(identity)),
+		DashboardURL:   types.StringValue(identity.DashboardUrl),
+		APIKeyName:     types.StringValue(identity.Name),
+		Roles:          types.SetValueMust(types.StringType, roles),
+		TeamRoles:      types.SetValueMust(types.StringType, teamRoles),
+		TeamIDs:        types.SetValueMust(types.StringType, teamIDs),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// organisationID is the ID of the organisation an API key belongs to, from the first part
+// of the path of its dashboard URL, or empty if the URL doesn't have one.
+func organisationID(identity client.IdentityV1) string {
+	dashboardURL, err := url.Parse(identity.DashboardUrl)
+	if err != nil {
+		return ""
+	}
+
+	id, _, _ := strings.Cut(strings.TrimPrefix(dashboardURL.Path, "/"), "/")
+
+	return id
+}
@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func testIdentityAPI() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /v1/identity", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"identity": {"dashboard_url": "https://app.incident.io/my-org", "name": "CI",
+			"roles": ["viewer"], "team_roles": ["member"], "teams": [{"id": "01TEAM", "name": "Platform"}]}}`))
+	})
+
+	return mux
+}
+
+func TestProviderExpectedOrganisation(t *testing.T) {
+	t.Run("configures when the key belongs to the expected organisation", func(t *testing.T) {
+		_, diags := testProviderServer(t, testIdentityAPI(), map[string]tftypes.Value{
+			"expected_organisation_id": tftypes.NewValue(tftypes.String, "my-org"),
+		})
+
+		assert.Empty(t, diags)
+	})
+
+	t.Run("fails when the key belongs to another organisation", func(t *testing.T) {
+		_, diags := testProviderServer(t, testIdentityAPI(), map[string]tftypes.Value{
+			"expected_organisation_id": tftypes.NewValue(tftypes.String, "other-org"),
+		})
+
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+			assert.Equal(t, "Wrong organisation", diags[0].Summary)
+			assert.Equal(t, tftypes.NewAttributePath().WithAttributeName("expected_organisation_id"), diags[0].Attribute)
+		}
+	})
+
+	t.Run("doesn't check the key unless asked to", func(t *testing.T) {
+		api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		})
+		_, diags := testProviderServer(t, api, nil)
+
+		assert.Empty(t, diags)
+	})
+}
+
+func TestIncidentIdentityDataSourceRead(t *testing.T) {
+	providerServer, diags := testProviderServer(t, testIdentityAPI(), nil)
+	if len(diags) > 0 {
+		t.Fatalf("configuring the provider: %+v", diags)
+	}
+
+	stringSet := tftypes.Set{ElementType: tftypes.String}
+	identityType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"organisation_id": tftypes.String,
+		"dashboard_url":   tftypes.String,
+		"api_key_name":    tftypes.String,
+		"roles":           stringSet,
+		"team_roles":      stringSet,
+		"team_ids":        stringSet,
+	}}
+	nulls := map[string]tftypes.Value{}
+	for name, attrType := range identityType.AttributeTypes {
+		nulls[name] = tftypes.NewValue(attrType, nil)
+	}
+	config, err := tfprotov6.NewDynamicValue(identityType, tftypes.NewValue(identityType, nulls))
+	if err != nil {
+		t.Fatalf("building the data source config: %v", err)
+	}
+
+	resp, err := providerServer.ReadDataSource(context.Background(), &tfprotov6.ReadDataSourceRequest{
+		TypeName: "incident_identity",
+		Config:   &config,
+	})
+	if err != nil {
+		t.Fatalf("reading the data source: %v", err)
+	}
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("reading the data source: %+v", resp.Diagnostics)
+	}
+
+	state, err := resp.State.Unmarshal(identityType)
+	if err != nil {
+		t.Fatalf("unmarshalling the state: %v", err)
+	}
+	assert.Equal(t, tftypes.NewValue(identityType, map[string]tftypes.Value{
+		"organisation_id": tftypes.NewValue(tftypes.String, "my-org"),
+		"dashboard_url":   tftypes.NewValue(tftypes.String, "https://app.incident.io/my-org"),
+		"api_key_name":    tftypes.NewValue(tftypes.String, "CI"),
+		"roles":           tftypes.NewValue(stringSet, []tftypes.Value{tftypes.NewValue(tftypes.String, "viewer")}),
+		"team_roles":      tftypes.NewValue(stringSet, []tftypes.Value{tftypes.NewValue(tftypes.String, "member")}),
+		"team_ids":        tftypes.NewValue(stringSet, []tftypes.Value{tftypes.NewValue(tftypes.String, "01TEAM")}),
+	}), state)
+}
+
+func TestOrganisationID(t *testing.T) {
+	cases := map[string]string{
+		"https://app.incident.io/my-org":           "my-org",
+		"https://app.incident.io/my-org/incidents": "my-org",
+		"https://app.incident.io":                  "",
+		"://not a url":                             "",
+	}
+
+	for dashboardURL, want := range cases {
+		assert.Equal(t, want, organisationID(client.IdentityV1{DashboardUrl: dashboardURL}), dashboardURL)
+	}
+}
@@ -69,7 +69,7 @@ func (d *IncidentIncidentTypesDataSource) Read(ctx context.Context, req datasour
 	// Get all incident types
 	result, err := d.client.IncidentTypesV1ListWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list incident types, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list incident types", err)
 		return
 	}
 
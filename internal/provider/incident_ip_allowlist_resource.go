@@ -0,0 +1,281 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// ipAllowlistID is the ID of the organisation's IP allowlist. There's only ever one, so
+// it doesn't have an ID in the API: we use a fixed one to give Terraform something to
+// track.
+const ipAllowlistID = "ip_allowlist"
+
+var (
+	_ resource.Resource                = &IncidentIPAllowlistResource{}
+	_ resource.ResourceWithConfigure   = &IncidentIPAllowlistResource{}
+	_ resource.ResourceWithImportState = &IncidentIPAllowlistResource{}
+	_ resource.ResourceWithIdentity    = &IncidentIPAllowlistResource{}
+)
+
+type IncidentIPAllowlistResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentIPAllowlistResourceModel struct {
+	ID        types.String                   `tfsdk:"id"`
+	Enabled   types.Bool                     `tfsdk:"enabled"`
+	Allowlist []IncidentIPAllowlistItemModel `tfsdk:"allowlist"`
+	Timeouts  timeouts.Value                 `tfsdk:"timeouts"`
+}
+
+type IncidentIPAllowlistItemModel struct {
+	Value types.String `tfsdk:"value"`
+	Label types.String `tfsdk:"label"`
+}
+
+func NewIncidentIPAllowlistResource() resource.Resource {
+	return &IncidentIPAllowlistResource{}
+}
+
+func (r *IncidentIPAllowlistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_allowlist"
+}
+
+func (r *IncidentIPAllowlistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage your organisation's IP allowlist, which restricts access to the dashboard and
+API to the listed IP addresses and CIDR prefixes.
+
+There's only one allowlist per organisation, so only declare this resource once. Creating
+it replaces whatever allowlist is already configured, and destroying it empties and
+disables the allowlist.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: fmt.Sprintf("Always `%s`, as there's only one allowlist per organisation.", ipAllowlistID),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the allowlist is enforced. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"allowlist": schema.SetNestedAttribute{
+				MarkdownDescription: apischema.Docstring("IPAllowlistV1", "allowlist"),
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"value": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IPAllowlistItemV1", "value"),
+							Required:            true,
+							Validators: []validator.String{
+								IPOrCIDRValidator{},
+							},
+						},
+						"label": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("IPAllowlistItemV1", "label"),
+							Optional:            true,
+							Validators: []validator.String{
+								// An empty label reads back as unset, so leave it out instead.
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentIPAllowlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+func (r *IncidentIPAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	allowlist, err := r.update(ctx, data.Enabled.ValueBool(), r.buildItems(data))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update IP allowlist", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("updated the IP allowlist to version %d", allowlist.Version))
+	model := r.buildModel(*allowlist)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentIPAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.IPAllowlistsV1ShowIPAllowlistWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read IP allowlist", err)
+		return
+	}
+
+	model := r.buildModel(result.JSON200.IpAllowlist)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentIPAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	allowlist, err := r.update(ctx, data.Enabled.ValueBool(), r.buildItems(data))
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update IP allowlist", err)
+		return
+	}
+
+	model := r.buildModel(*allowlist)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+// Delete can't remove the allowlist, as every organisation has one, so it empties and
+// disables it instead.
+func (r *IncidentIPAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentIPAllowlistResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.update(ctx, false, []client.IPAllowlistItemV1{})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to reset IP allowlist", err)
+		return
+	}
+}
+
+// ImportState accepts any ID, as there's only one allowlist to import.
+func (r *IncidentIPAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ipAllowlistID)...)
+}
+
+func (r *IncidentIPAllowlistResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"id": fmt.Sprintf("Always `%s`, as there's one allowlist per organisation.", ipAllowlistID),
+	})
+}
+
+// update replaces the allowlist. The API rejects updates that don't carry the current
+// version, so we look that up first rather than trusting whatever is in state.
+func (r *IncidentIPAllowlistResource) update(ctx context.Context, enabled bool, items []client.IPAllowlistItemV1) (*client.IPAllowlistV1, error) {
+	current, err := r.client.IPAllowlistsV1ShowIPAllowlistWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := r.client.IPAllowlistsV1UpdateIPAllowlistWithResponse(ctx, client.IPAllowlistsV1UpdateIPAllowlistJSONRequestBody{
+		Allowlist: items,
+		Enabled:   enabled,
+		Version:   current.JSON200.IpAllowlist.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &result.JSON200.IpAllowlist, nil
+}
+
+func (r *IncidentIPAllowlistResource) buildItems(data *IncidentIPAllowlistResourceModel) []client.IPAllowlistItemV1 {
+	items := []client.IPAllowlistItemV1{}
+	for _, item := range data.Allowlist {
+		items = append(items, client.IPAllowlistItemV1{
+			Value: item.Value.ValueString(),
+			Label: item.Label.ValueStringPointer(),
+		})
+	}
+
+	return items
+}
+
+// buildModel converts from the response type to the terraform model/schema type.
+func (r *IncidentIPAllowlistResource) buildModel(allowlist client.IPAllowlistV1) *IncidentIPAllowlistResourceModel {
+	model := &IncidentIPAllowlistResourceModel{
+		ID:        types.StringValue(ipAllowlistID),
+		Enabled:   types.BoolValue(allowlist.Enabled),
+		Allowlist: []IncidentIPAllowlistItemModel{},
+	}
+	for _, item := range allowlist.Allowlist {
+		label := types.StringPointerValue(item.Label)
+		// Treat an empty label as unset, so leaving it out of config doesn't cause a diff.
+		if item.Label != nil && *item.Label == "" {
+			label = types.StringNull()
+		}
+		model.Allowlist = append(model.Allowlist, IncidentIPAllowlistItemModel{
+			Value: types.StringValue(item.Value),
+			Label: label,
+		})
+	}
+
+	return model
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// TestAccIncidentIPAllowlistResource keeps the allowlist disabled throughout: enabling it
+// would lock the test runner out of the API.
+func TestAccIncidentIPAllowlistResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentIPAllowlistResourceConfig([]ipAllowlistTestItem{
+					{Value: "192.0.2.0/24", Label: "London HQ"},
+					{Value: "198.51.100.7"},
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incident_ip_allowlist.example", "id", "ip_allowlist"),
+					resource.TestCheckResourceAttr("incident_ip_allowlist.example", "enabled", "false"),
+					resource.TestCheckResourceAttr("incident_ip_allowlist.example", "allowlist.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("incident_ip_allowlist.example", "allowlist.*", map[string]string{
+						"value": "192.0.2.0/24",
+						"label": "London HQ",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("incident_ip_allowlist.example", "allowlist.*", map[string]string{
+						"value": "198.51.100.7",
+					}),
+				),
+			},
+			// Reordering entries is a no-op, as they're a set
+			{
+				Config: testAccIncidentIPAllowlistResourceConfig([]ipAllowlistTestItem{
+					{Value: "198.51.100.7"},
+					{Value: "192.0.2.0/24", Label: "London HQ"},
+				}),
+				PlanOnly: true,
+			},
+			// Update
+			{
+				Config: testAccIncidentIPAllowlistResourceConfig([]ipAllowlistTestItem{
+					{Value: "192.0.2.0/24", Label: "London office"},
+				}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incident_ip_allowlist.example", "allowlist.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("incident_ip_allowlist.example", "allowlist.*", map[string]string{
+						"value": "192.0.2.0/24",
+						"label": "London office",
+					}),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_ip_allowlist.example",
+				ImportState:       true,
+				ImportStateId:     "ip_allowlist",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+type ipAllowlistTestItem struct {
+	Value string
+	Label string
+}
+
+func testAccIncidentIPAllowlistResourceConfig(items []ipAllowlistTestItem) string {
+	return testRunTemplate("incident_ip_allowlist", `
+resource "incident_ip_allowlist" "example" {
+  enabled = false
+
+  allowlist = [
+{{- range .Items }}
+    {
+      value = {{ quote .Value }}
+      {{- if .Label }}
+      label = {{ quote .Label }}
+      {{- end }}
+    },
+{{- end }}
+  ]
+}
+`, struct {
+		Items []ipAllowlistTestItem
+	}{
+		Items: items,
+	})
+}
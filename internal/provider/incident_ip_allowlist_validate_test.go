@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIncidentIPAllowlistResourceValidateConfig(t *testing.T) {
+	var schemaResp resource.SchemaResponse
+	NewIncidentIPAllowlistResource().Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	objType, ok := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+	itemType := objType.AttributeTypes["allowlist"].(tftypes.Set).ElementType
+
+	validate := func(value, label tftypes.Value) []*tfprotov6.Diagnostic {
+		config, err := tfprotov6.NewDynamicValue(objType, tftypes.NewValue(objType, map[string]tftypes.Value{
+			"id":      tftypes.NewValue(tftypes.String, nil),
+			"enabled": tftypes.NewValue(tftypes.Bool, false),
+			"allowlist": tftypes.NewValue(objType.AttributeTypes["allowlist"], []tftypes.Value{
+				tftypes.NewValue(itemType, map[string]tftypes.Value{"value": value, "label": label}),
+			}),
+			"timeouts": tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
+		}))
+		if err != nil {
+			t.Fatalf("building the config: %v", err)
+		}
+
+		providerServer, err := testAccProtoV6ProviderFactories["incident"]()
+		if err != nil {
+			t.Fatalf("building the provider server: %v", err)
+		}
+		resp, err := providerServer.ValidateResourceConfig(context.Background(), &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: "incident_ip_allowlist",
+			Config:   &config,
+		})
+		if err != nil {
+			t.Fatalf("validating the config: %v", err)
+		}
+
+		return resp.Diagnostics
+	}
+
+	t.Run("accepts a labelled prefix", func(t *testing.T) {
+		assert.Empty(t, validate(tftypes.NewValue(tftypes.String, "10.0.0.0/8"), tftypes.NewValue(tftypes.String, "Office")))
+	})
+
+	t.Run("accepts no label", func(t *testing.T) {
+		assert.Empty(t, validate(tftypes.NewValue(tftypes.String, "10.0.0.0/8"), tftypes.NewValue(tftypes.String, nil)))
+	})
+
+	t.Run("rejects an empty label", func(t *testing.T) {
+		assert.Len(t, validate(tftypes.NewValue(tftypes.String, "10.0.0.0/8"), tftypes.NewValue(tftypes.String, "")), 1)
+	})
+
+	t.Run("rejects a prefix with host bits set", func(t *testing.T) {
+		diags := validate(tftypes.NewValue(tftypes.String, "10.0.0.1/8"), tftypes.NewValue(tftypes.String, nil))
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "Non-canonical CIDR Prefix", diags[0].Summary)
+		}
+	})
+}
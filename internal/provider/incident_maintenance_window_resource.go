@@ -6,7 +6,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -25,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentMaintenanceWindowResource{}
 	_ resource.ResourceWithImportState = &IncidentMaintenanceWindowResource{}
+	_ resource.ResourceWithIdentity    = &IncidentMaintenanceWindowResource{}
 )
 
 type IncidentMaintenanceWindowResource struct {
@@ -47,6 +48,7 @@ type MaintenanceWindowResourceModel struct {
 	NotifyEndMinutesBefore   types.Int64                              `tfsdk:"notify_end_minutes_before"`
 	NotificationMessage      types.String                             `tfsdk:"notification_message"`
 	IncidentID               types.String                             `tfsdk:"incident_id"`
+	Timeouts                 timeouts.Value                           `tfsdk:"timeouts"`
 }
 
 type MaintenanceWindowEscalationTargetModel struct {
@@ -181,6 +183,9 @@ func (r *IncidentMaintenanceWindowResource) Schema(ctx context.Context, req reso
 				Optional:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -209,6 +214,9 @@ func (r *IncidentMaintenanceWindowResource) Create(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	payload, err := r.buildPayload(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build maintenance window payload: %s", err))
@@ -232,13 +240,14 @@ func (r *IncidentMaintenanceWindowResource) Create(ctx context.Context, req reso
 		IncidentId:               payload.IncidentID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create maintenance window", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created a maintenance window resource with id=%s", result.JSON201.MaintenanceWindow.Id))
 	data = r.buildModel(result.JSON201.MaintenanceWindow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentMaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -248,6 +257,9 @@ func (r *IncidentMaintenanceWindowResource) Read(ctx context.Context, req resour
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.MaintenanceWindowsV1ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		httpErr := client.HTTPError{}
@@ -256,12 +268,13 @@ func (r *IncidentMaintenanceWindowResource) Read(ctx context.Context, req resour
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read maintenance window, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read maintenance window", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.MaintenanceWindow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentMaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -271,6 +284,9 @@ func (r *IncidentMaintenanceWindowResource) Update(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	payload, err := r.buildPayload(data)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to build maintenance window payload: %s", err))
@@ -294,12 +310,13 @@ func (r *IncidentMaintenanceWindowResource) Update(ctx context.Context, req reso
 		IncidentId:               payload.IncidentID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance window, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update maintenance window", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.MaintenanceWindow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentMaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -309,15 +326,22 @@ func (r *IncidentMaintenanceWindowResource) Delete(ctx context.Context, req reso
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.MaintenanceWindowsV1DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance window, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete maintenance window", err)
 		return
 	}
 }
 
 func (r *IncidentMaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentMaintenanceWindowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 // buildPayload is a shared helper used by both Create and Update.
@@ -470,6 +494,7 @@ func (r *IncidentMaintenanceWindowResource) buildModel(mw client.MaintenanceWind
 
 	if prior != nil {
 		model.AlertConditionGroups.ReconcileOperations(prior.AlertConditionGroups)
+		model.Timeouts = prior.Timeouts
 	}
 
 	return model
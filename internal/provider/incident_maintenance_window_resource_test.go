@@ -273,8 +273,9 @@ resource "incident_maintenance_window" "example" {
 
 func maintenanceWindowDefault(t *testing.T) maintenanceWindowModel {
 	// Use dates in the future to avoid issues with validation
-	startAt := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
-	endAt := time.Now().Add(28 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
+	now := testAccNow(t)
+	startAt := now.Add(24 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
+	endAt := now.Add(28 * time.Hour).Truncate(time.Second).UTC().Format(time.RFC3339)
 
 	return maintenanceWindowModel{
 		Name:          StableSuffix("Test Maintenance Window"),
@@ -94,7 +94,7 @@ func (i *IncidentRoleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 		result, err := i.client.IncidentRolesV2ShowWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read role, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read role", err)
 			return
 		}
 		role = &result.JSON200.IncidentRole
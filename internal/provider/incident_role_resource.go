@@ -5,7 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentRoleResource{}
 	_ resource.ResourceWithImportState = &IncidentRoleResource{}
+	_ resource.ResourceWithIdentity    = &IncidentRoleResource{}
 )
 
 type IncidentRoleResource struct {
@@ -27,17 +29,22 @@ type IncidentRoleResource struct {
 }
 
 type IncidentRoleResourceModel struct {
-	ID           types.String `tfsdk:"id"`
-	Name         types.String `tfsdk:"name"`
-	Description  types.String `tfsdk:"description"`
-	Instructions types.String `tfsdk:"instructions"`
-	Shortform    types.String `tfsdk:"shortform"`
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Description  types.String   `tfsdk:"description"`
+	Instructions types.String   `tfsdk:"instructions"`
+	Shortform    types.String   `tfsdk:"shortform"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentRoleResource() resource.Resource {
 	return &IncidentRoleResource{}
 }
 
+func NewIncidentRoleListResource() list.ListResource {
+	return newIDListResource(NewIncidentRoleResource, listRoleResources)
+}
+
 func (r *IncidentRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_incident_role"
 }
@@ -70,6 +77,9 @@ func (r *IncidentRoleResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -98,6 +108,9 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentRolesV2CreateWithResponse(ctx, client.IncidentRolesV2CreateJSONRequestBody{
 		Name:         data.Name.ValueString(),
 		Description:  data.Description.ValueString(),
@@ -105,13 +118,16 @@ func (r *IncidentRoleResource) Create(ctx context.Context, req resource.CreateRe
 		Shortform:    data.Shortform.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident role, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create incident role", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident role resource with id=%s", result.JSON201.IncidentRole.Id))
-	data = r.buildModel(result.JSON201.IncidentRole)
+	model := r.buildModel(result.JSON201.IncidentRole)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -121,6 +137,9 @@ func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentRolesV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -130,12 +149,15 @@ func (r *IncidentRoleResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident role, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read incident role", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentRole)
+	model := r.buildModel(result.JSON200.IncidentRole)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -145,6 +167,9 @@ func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentRolesV2UpdateWithResponse(ctx, data.ID.ValueString(), client.IncidentRolesV2UpdateJSONRequestBody{
 		Name:         data.Name.ValueString(),
 		Description:  data.Description.ValueString(),
@@ -152,12 +177,15 @@ func (r *IncidentRoleResource) Update(ctx context.Context, req resource.UpdateRe
 		Shortform:    data.Shortform.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident role, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update incident role", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentRole)
+	model := r.buildModel(result.JSON200.IncidentRole)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -167,15 +195,22 @@ func (r *IncidentRoleResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.IncidentRolesV2DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident role, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete incident role", err)
 		return
 	}
 }
 
 func (r *IncidentRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentRoleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentRoleResource) buildModel(role client.IncidentRoleV2) *IncidentRoleResourceModel {
@@ -187,3 +222,25 @@ func (r *IncidentRoleResource) buildModel(role client.IncidentRoleV2) *IncidentR
 		Shortform:    types.StringValue(role.Shortform),
 	}
 }
+
+// listRoleResources lists the custom incident roles for `terraform query`. The lead and
+// reporter roles are built in, so can't be managed.
+func listRoleResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.IncidentRolesV2ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing incident roles: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.IncidentRoles {
+		if item.RoleType != client.IncidentRoleV2RoleTypeCustom {
+			continue
+		}
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
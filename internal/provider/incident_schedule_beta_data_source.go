@@ -122,18 +122,18 @@ func (d *IncidentScheduleBetaDataSource) Read(ctx context.Context, req datasourc
 	case !data.ID.IsNull():
 		result, err := d.client.SchedulesV3ShowWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read schedule", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", err)
 			return
 		}
 		if result.JSON200 == nil {
-			resp.Diagnostics.AddError("Unable to read schedule", fmt.Sprintf("unexpected response: %s", result.Status()))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", fmt.Errorf("unexpected response: %s", result.Status()))
 			return
 		}
 		schedule = &result.JSON200.Schedule
 	case !data.Name.IsNull():
 		got, err := d.findByName(ctx, data.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read schedule by name", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule by name", err)
 			return
 		}
 		schedule = got
@@ -10,6 +10,7 @@ import (
 	// would validate on a laptop and fail in a slim CI image.
 	_ "time/tzdata"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -27,6 +28,7 @@ var (
 	_ resource.Resource                   = &IncidentScheduleBetaResource{}
 	_ resource.ResourceWithConfigure      = &IncidentScheduleBetaResource{}
 	_ resource.ResourceWithImportState    = &IncidentScheduleBetaResource{}
+	_ resource.ResourceWithIdentity       = &IncidentScheduleBetaResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentScheduleBetaResource{}
 )
 
@@ -46,6 +48,7 @@ type IncidentScheduleBetaModel struct {
 	Timezone             types.String                        `tfsdk:"timezone"`
 	TeamIDs              types.Set                           `tfsdk:"team_ids"`
 	HolidaysPublicConfig *IncidentScheduleBetaHolidaysConfig `tfsdk:"holidays_public_config"`
+	Timeouts             timeouts.Value                      `tfsdk:"timeouts"`
 }
 
 type IncidentScheduleBetaHolidaysConfig struct {
@@ -56,7 +59,7 @@ func (r *IncidentScheduleBetaResource) Metadata(_ context.Context, req resource.
 	resp.TypeName = req.ProviderTypeName + "_schedule_beta"
 }
 
-func (r *IncidentScheduleBetaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IncidentScheduleBetaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manage an on-call schedule.
 
@@ -152,6 +155,9 @@ shifts along with it.`,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -298,6 +304,9 @@ func (r *IncidentScheduleBetaResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	teamIDs := r.toTeamIDsPayload(ctx, data.TeamIDs, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
 		return
@@ -313,15 +322,18 @@ func (r *IncidentScheduleBetaResource) Create(ctx context.Context, req resource.
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to create schedule", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule", err)
 		return
 	}
 	if result.JSON201 == nil {
-		resp.Diagnostics.AddError("Unable to create schedule", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleBetaFromAPI(result.JSON201.Schedule, data.TeamIDs))...)
+	model := incidentScheduleBetaFromAPI(result.JSON201.Schedule, data.TeamIDs)
+	model.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *IncidentScheduleBetaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -331,24 +343,30 @@ func (r *IncidentScheduleBetaResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.SchedulesV3ShowWithResponse(ctx, data.ID.ValueString())
 	if isNotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read schedule", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", err)
 		return
 	}
 	// The client turns any non-2xx into an error, so a missing body here is an
 	// unexpected success response rather than a deleted schedule. Dropping it from
 	// state would make the next apply create a second schedule, so fail instead.
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to read schedule", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleBetaFromAPI(result.JSON200.Schedule, data.TeamIDs))...)
+	model := incidentScheduleBetaFromAPI(result.JSON200.Schedule, data.TeamIDs)
+	model.Timeouts = data.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *IncidentScheduleBetaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -359,6 +377,9 @@ func (r *IncidentScheduleBetaResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	// Always send team_ids, as an empty list when the attribute is unset: omitting
 	// it tells the API to leave ownership alone, which would strand a schedule on
 	// its old teams after they're removed from the config.
@@ -379,15 +400,18 @@ func (r *IncidentScheduleBetaResource) Update(ctx context.Context, req resource.
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update schedule", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule", err)
 		return
 	}
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to update schedule", fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleBetaFromAPI(result.JSON200.Schedule, plan.TeamIDs))...)
+	model := incidentScheduleBetaFromAPI(result.JSON200.Schedule, plan.TeamIDs)
+	model.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "id")
 }
 
 func (r *IncidentScheduleBetaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -397,17 +421,28 @@ func (r *IncidentScheduleBetaResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.SchedulesV3DestroyWithResponse(ctx, data.ID.ValueString())
 	if err != nil && !isNotFound(err) {
-		resp.Diagnostics.AddError("Unable to delete schedule", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule", err)
 	}
 }
 
 func (r *IncidentScheduleBetaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Create and Update carry the Terraform annotation in their payload, but an
 	// import writes nothing, so claim the schedule here instead.
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeSchedule, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeSchedule, r.terraformVersion)
+}
+
+func (r *IncidentScheduleBetaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentScheduleBetaResource) annotations() *map[string]string {
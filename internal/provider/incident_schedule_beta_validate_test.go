@@ -36,6 +36,7 @@ func scheduleV3ConfigFor(t *testing.T, holidays tftypes.Value) tfsdk.Config {
 			"timezone":               tftypes.NewValue(tftypes.String, "Europe/London"),
 			"team_ids":               tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 			"holidays_public_config": holidays,
+			"timeouts":               tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
 		}),
 	}
 }
@@ -172,6 +173,7 @@ func TestScheduleV3ValidateTimezone(t *testing.T) {
 				"timezone":               timezone,
 				"team_ids":               tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, nil),
 				"holidays_public_config": tftypes.NewValue(holidaysObjectType, nil),
+				"timeouts":               tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
 			}),
 		}
 
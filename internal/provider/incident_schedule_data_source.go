@@ -97,7 +97,7 @@ func (d *IncidentScheduleDataSource) Read(ctx context.Context, req datasource.Re
 		// Lookup by ID
 		result, err := d.client.SchedulesV2ShowWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", err)
 			return
 		}
 		schedule = &result.JSON200.Schedule
@@ -108,7 +108,7 @@ func (d *IncidentScheduleDataSource) Read(ctx context.Context, req datasource.Re
 		// Step 1: Get the cached Schedule catalog type ID
 		scheduleTypeID, err := d.getScheduleTypeID(ctx)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "", err)
 			return
 		}
 
@@ -122,7 +122,7 @@ func (d *IncidentScheduleDataSource) Read(ctx context.Context, req datasource.Re
 			err = fmt.Errorf("%s", entriesResult.Body)
 		}
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list catalog entries, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list catalog entries", err)
 			return
 		}
 
@@ -141,7 +141,7 @@ func (d *IncidentScheduleDataSource) Read(ctx context.Context, req datasource.Re
 		scheduleID := *catalogEntry.ExternalId
 		result, err := d.client.SchedulesV2ShowWithResponse(ctx, scheduleID)
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule with ID %s, got error: %s", scheduleID, err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to read schedule with ID %s", scheduleID), err)
 			return
 		}
 		schedule = &result.JSON200.Schedule
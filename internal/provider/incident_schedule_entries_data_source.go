@@ -0,0 +1,452 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentScheduleEntriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentScheduleEntriesDataSource{}
+)
+
+func NewIncidentScheduleEntriesDataSource() datasource.DataSource {
+	return &IncidentScheduleEntriesDataSource{}
+}
+
+type IncidentScheduleEntriesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleEntriesDataSourceModel struct {
+	ScheduleID types.String                         `tfsdk:"schedule_id"`
+	From       types.String                         `tfsdk:"from"`
+	To         types.String                         `tfsdk:"to"`
+	Preview    *IncidentScheduleEntriesPreviewModel `tfsdk:"preview"`
+	Scheduled  []IncidentScheduleEntryModel         `tfsdk:"scheduled"`
+	Overrides  []IncidentScheduleEntryModel         `tfsdk:"overrides"`
+	Final      []IncidentScheduleEntryModel         `tfsdk:"final"`
+	Gaps       []IncidentScheduleEntriesGapModel    `tfsdk:"gaps"`
+}
+
+type IncidentScheduleEntriesPreviewModel struct {
+	Timezone  types.String        `tfsdk:"timezone"`
+	Rotations []models.RotationV2 `tfsdk:"rotations"`
+}
+
+type IncidentScheduleEntryModel struct {
+	EntryID     types.String `tfsdk:"entry_id"`
+	Fingerprint types.String `tfsdk:"fingerprint"`
+	RotationID  types.String `tfsdk:"rotation_id"`
+	LayerID     types.String `tfsdk:"layer_id"`
+	UserID      types.String `tfsdk:"user_id"`
+	StartAt     types.String `tfsdk:"start_at"`
+	EndAt       types.String `tfsdk:"end_at"`
+}
+
+type IncidentScheduleEntriesGapModel struct {
+	StartAt types.String `tfsdk:"start_at"`
+	EndAt   types.String `tfsdk:"end_at"`
+}
+
+func (d *IncidentScheduleEntriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentScheduleEntriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_entries"
+}
+
+func (d *IncidentScheduleEntriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentScheduleEntriesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var from, to *time.Time
+	if !data.From.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.From.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid from", err.Error())
+			return
+		}
+		from = &parsed
+	}
+	if !data.To.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.To.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid to", err.Error())
+			return
+		}
+		to = &parsed
+	}
+
+	var entries client.ScheduleEntriesListPayloadV2
+	if data.Preview != nil {
+		rotations, err := buildScheduleUpdatePayload(&models.IncidentScheduleResourceModelV2{
+			Rotations: data.Preview.Rotations,
+		}, &resp.Diagnostics)
+		if err != nil || resp.Diagnostics.HasError() {
+			return
+		}
+
+		result, err := d.client.SchedulesV2PreviewScheduleEntriesWithResponse(ctx, data.ScheduleID.ValueString(), client.SchedulesV2PreviewScheduleEntriesJSONRequestBody{
+			EntryWindowStart: from,
+			EntryWindowEnd:   to,
+			Schedule: client.ScheduleUpdatePayloadV2{
+				Timezone: data.Preview.Timezone.ValueStringPointer(),
+				Config: &client.ScheduleConfigUpdatePayloadV2{
+					Rotations: &rotations,
+				},
+			},
+		})
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to preview schedule entries", err)
+			return
+		}
+		entries = result.JSON200.ScheduleEntries
+	} else {
+		var err error
+		entries, err = listScheduleEntries(ctx, d.client, data.ScheduleID.ValueString(), data.From.ValueStringPointer(), to)
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list schedule entries", err)
+			return
+		}
+	}
+
+	data.Scheduled = buildScheduleEntryModels(entries.Scheduled)
+	data.Overrides = buildScheduleEntryModels(entries.Overrides)
+	data.Final = buildScheduleEntryModels(entries.Final)
+
+	data.Gaps = []IncidentScheduleEntriesGapModel{}
+	for _, gap := range scheduleEntryGaps(entries.Final, from, to) {
+		data.Gaps = append(data.Gaps, IncidentScheduleEntriesGapModel{
+			StartAt: types.StringValue(gap.StartAt.Format(time.RFC3339)),
+			EndAt:   types.StringValue(gap.EndAt.Format(time.RFC3339)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listScheduleEntries fetches every page of entries in the window. The API returns the
+// cursor for the next page in place of the window start, so we pass it back there.
+func listScheduleEntries(ctx context.Context, apiClient *client.ClientWithResponses, scheduleID string, from *string, to *time.Time) (client.ScheduleEntriesListPayloadV2, error) {
+	entries := client.ScheduleEntriesListPayloadV2{
+		Scheduled: []client.ScheduleEntryV2{},
+		Overrides: []client.ScheduleEntryV2{},
+		Final:     []client.ScheduleEntryV2{},
+	}
+
+	windowStart := from
+	for {
+		result, err := apiClient.SchedulesV2ListScheduleEntriesWithResponse(ctx, &client.SchedulesV2ListScheduleEntriesParams{
+			ScheduleId:       scheduleID,
+			EntryWindowStart: windowStart,
+			EntryWindowEnd:   to,
+		})
+		if err != nil {
+			return entries, err
+		}
+
+		page := result.JSON200.ScheduleEntries
+		entries.Scheduled = append(entries.Scheduled, page.Scheduled...)
+		entries.Overrides = append(entries.Overrides, page.Overrides...)
+		entries.Final = append(entries.Final, page.Final...)
+
+		meta := result.JSON200.PaginationMeta
+		if meta == nil || meta.After == "" || (windowStart != nil && meta.After == *windowStart) {
+			break
+		}
+		windowStart = &meta.After
+	}
+
+	return entries, nil
+}
+
+func buildScheduleEntryModels(entries []client.ScheduleEntryV2) []IncidentScheduleEntryModel {
+	result := []IncidentScheduleEntryModel{}
+	for _, entry := range entries {
+		model := IncidentScheduleEntryModel{
+			EntryID:     types.StringPointerValue(entry.EntryId),
+			Fingerprint: types.StringPointerValue(entry.Fingerprint),
+			RotationID:  types.StringPointerValue(entry.RotationId),
+			LayerID:     types.StringPointerValue(entry.LayerId),
+			UserID:      types.StringNull(),
+			StartAt:     types.StringValue(entry.StartAt.Format(time.RFC3339)),
+			EndAt:       types.StringValue(entry.EndAt.Format(time.RFC3339)),
+		}
+		if entry.User != nil {
+			model.UserID = types.StringValue(entry.User.Id)
+		}
+
+		result = append(result, model)
+	}
+
+	return result
+}
+
+type scheduleEntryGap struct {
+	StartAt time.Time
+	EndAt   time.Time
+}
+
+// scheduleEntryGaps returns the periods within the window from from to to that no entry
+// covers. When either end of the window is nil, the earliest start or latest end of the
+// entries stands in for it, so an open window never reports a gap at its edges.
+func scheduleEntryGaps(entries []client.ScheduleEntryV2, from, to *time.Time) []scheduleEntryGap {
+	sorted := make([]client.ScheduleEntryV2, len(entries))
+	copy(sorted, entries)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].StartAt.Before(sorted[j].StartAt)
+	})
+
+	if len(sorted) == 0 {
+		if from != nil && to != nil && from.Before(*to) {
+			return []scheduleEntryGap{{StartAt: *from, EndAt: *to}}
+		}
+		return nil
+	}
+
+	cursor := sorted[0].StartAt
+	if from != nil {
+		cursor = *from
+	}
+
+	gaps := []scheduleEntryGap{}
+	for _, entry := range sorted {
+		if to != nil && !entry.StartAt.Before(*to) {
+			break
+		}
+		if entry.StartAt.After(cursor) {
+			gaps = append(gaps, scheduleEntryGap{StartAt: cursor, EndAt: entry.StartAt})
+		}
+		if entry.EndAt.After(cursor) {
+			cursor = entry.EndAt
+		}
+	}
+	if to != nil && cursor.Before(*to) {
+		gaps = append(gaps, scheduleEntryGap{StartAt: cursor, EndAt: *to})
+	}
+
+	return gaps
+}
+
+func (d *IncidentScheduleEntriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	entryAttributes := map[string]schema.Attribute{
+		"entry_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2", "entry_id"),
+		},
+		"fingerprint": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2", "fingerprint"),
+		},
+		"rotation_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2", "rotation_id"),
+		},
+		"layer_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: apischema.Docstring("ScheduleEntryV2", "layer_id"),
+		},
+		"user_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The incident.io ID of the user who is on call for the entry.",
+		},
+		"start_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "When the entry starts, as an RFC3339 timestamp.",
+		},
+		"end_at": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "When the entry ends, as an RFC3339 timestamp.",
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List who is on call for a schedule within a window of time.
+
+Set ` + "`preview`" + ` to see the entries a schedule would have with different rotations, without
+saving them. Combined with ` + "`gaps`" + `, this lets you check a rotation change leaves nobody
+uncovered before you apply it.`,
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the schedule to list entries for.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The start of the window, as an RFC3339 timestamp. Defaults to now when previewing.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The end of the window, as an RFC3339 timestamp.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"preview": schema.SingleNestedAttribute{
+				Optional: true,
+				MarkdownDescription: "If set, list the entries the schedule would have with this configuration instead of its saved one. " +
+					"`rotations` takes the same shape as on `incident_schedule`.",
+				Attributes: map[string]schema.Attribute{
+					"timezone": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "The timezone to preview the schedule in. Defaults to the schedule's own timezone.",
+					},
+					"rotations": schema.SetNestedAttribute{
+						Required: true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"id": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "id"),
+								},
+								"name": schema.StringAttribute{
+									Required:            true,
+									MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "name"),
+								},
+								"versions": schema.SetNestedAttribute{
+									Required: true,
+									NestedObject: schema.NestedAttributeObject{
+										Attributes: map[string]schema.Attribute{
+											"users": schema.ListAttribute{
+												Required:            true,
+												ElementType:         types.StringType,
+												MarkdownDescription: apischema.Docstring("UserReferencePayloadV1", "id"),
+											},
+											"effective_from": schema.StringAttribute{
+												Optional:            true,
+												Validators:          []validator.String{RFC3339TimestampValidator{}},
+												MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "effective_from"),
+											},
+											"handover_start_at": schema.StringAttribute{
+												Required:            true,
+												Validators:          []validator.String{RFC3339TimestampValidator{}},
+												MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "handover_start_at"),
+											},
+											"working_intervals": schema.ListNestedAttribute{
+												Optional:            true,
+												MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "working_intervals"),
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"start_time": schema.StringAttribute{
+															Required: true,
+														},
+														"end_time": schema.StringAttribute{
+															Required: true,
+														},
+														"weekday": schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+											"layers": schema.ListNestedAttribute{
+												Required:            true,
+												MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "layers"),
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"id": schema.StringAttribute{
+															Required: true,
+														},
+														"name": schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+											"handovers": schema.ListNestedAttribute{
+												Required:            true,
+												MarkdownDescription: apischema.Docstring("ScheduleRotationV2", "handovers"),
+												NestedObject: schema.NestedAttributeObject{
+													Attributes: map[string]schema.Attribute{
+														"interval": schema.Int64Attribute{
+															Required: true,
+														},
+														"interval_type": schema.StringAttribute{
+															Description: EnumValuesDescription("ScheduleRotationHandoverV2", "interval_type"),
+															Required:    true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scheduled": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Entries from the schedule's rotations, before overrides are applied.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: entryAttributes,
+				},
+			},
+			"overrides": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Overrides that apply within the window.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: entryAttributes,
+				},
+			},
+			"final": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The effective schedule after overrides are merged in. This is normally the list to use when working out who is on call.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: entryAttributes,
+				},
+			},
+			"gaps": schema.ListNestedAttribute{
+				Computed: true,
+				MarkdownDescription: "Periods within the window when nobody is on call, in time order. " +
+					"If `from` or `to` is unset, the earliest or latest entry stands in for it.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"start_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the gap starts, as an RFC3339 timestamp.",
+						},
+						"end_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the gap ends, as an RFC3339 timestamp.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentScheduleEntriesDataSource(t *testing.T) {
+	// Skip before looking up a user, which needs a live API call.
+	testAccPreCheck(t)
+
+	userID := testAccMaintenanceWindowLeadUserID(t)
+	from := testAccNow(t).UTC().Truncate(time.Hour).Add(24 * time.Hour)
+	to := from.Add(7 * 24 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentScheduleEntriesDataSourceConfig(userID, from, to),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// The saved schedule always has someone on call
+					resource.TestCheckResourceAttrSet("data.incident_schedule_entries.saved", "final.0.start_at"),
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.saved", "final.0.user_id", userID),
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.saved", "gaps.#", "0"),
+
+					// Previewing the schedule without any users leaves the whole window uncovered
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.preview", "final.#", "0"),
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.preview", "gaps.#", "1"),
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.preview", "gaps.0.start_at", from.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("data.incident_schedule_entries.preview", "gaps.0.end_at", to.Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentScheduleEntriesDataSourceConfig(userID string, from, to time.Time) string {
+	return testRunTemplate("incident_schedule_entries", `
+resource "incident_schedule" "example" {
+  name     = {{ stableSuffix "Entries schedule" | quote }}
+  timezone = "Europe/London"
+  rotations = [
+    {
+      id   = "rota-primary"
+      name = "Primary"
+      versions = [
+        {
+          handover_start_at = "2024-04-26T16:00:00Z"
+          handovers = [
+            {
+              interval      = 1
+              interval_type = "weekly"
+            }
+          ]
+          users = [{{ quote .UserID }}]
+          layers = [
+            {
+              id   = "rota-primary-layer-one"
+              name = "Layer One"
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+
+data "incident_schedule_entries" "saved" {
+  schedule_id = incident_schedule.example.id
+  from        = {{ quote .From }}
+  to          = {{ quote .To }}
+}
+
+data "incident_schedule_entries" "preview" {
+  schedule_id = incident_schedule.example.id
+  from        = {{ quote .From }}
+  to          = {{ quote .To }}
+
+  preview = {
+    rotations = [
+      {
+        id   = "rota-primary"
+        name = "Primary"
+        versions = [
+          {
+            handover_start_at = "2024-04-26T16:00:00Z"
+            handovers = [
+              {
+                interval      = 1
+                interval_type = "weekly"
+              }
+            ]
+            users = []
+            layers = [
+              {
+                id   = "rota-primary-layer-one"
+                name = "Layer One"
+              }
+            ]
+          }
+        ]
+      }
+    ]
+  }
+}
+`, struct {
+		UserID string
+		From   string
+		To     string
+	}{
+		UserID: userID,
+		From:   from.Format(time.RFC3339),
+		To:     to.Format(time.RFC3339),
+	})
+}
+
+func TestScheduleEntryGaps(t *testing.T) {
+	at := func(hour int) time.Time {
+		return time.Date(2026, 6, 1, hour, 0, 0, 0, time.UTC)
+	}
+	entry := func(start, end int) client.ScheduleEntryV2 {
+		return client.ScheduleEntryV2{StartAt: at(start), EndAt: at(end)}
+	}
+
+	testCases := []struct {
+		name     string
+		entries  []client.ScheduleEntryV2
+		from, to *time.Time
+		expected []scheduleEntryGap
+	}{
+		{
+			name:     "fully covered",
+			entries:  []client.ScheduleEntryV2{entry(0, 12), entry(12, 24)},
+			from:     lo.ToPtr(at(0)),
+			to:       lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{},
+		},
+		{
+			name:     "gap between entries",
+			entries:  []client.ScheduleEntryV2{entry(0, 8), entry(10, 24)},
+			from:     lo.ToPtr(at(0)),
+			to:       lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{{StartAt: at(8), EndAt: at(10)}},
+		},
+		{
+			name:    "gaps at the edges of the window",
+			entries: []client.ScheduleEntryV2{entry(4, 20)},
+			from:    lo.ToPtr(at(0)),
+			to:      lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{
+				{StartAt: at(0), EndAt: at(4)},
+				{StartAt: at(20), EndAt: at(24)},
+			},
+		},
+		{
+			name:     "overlapping entries across rotations",
+			entries:  []client.ScheduleEntryV2{entry(0, 12), entry(6, 10), entry(11, 24)},
+			from:     lo.ToPtr(at(0)),
+			to:       lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{},
+		},
+		{
+			name:     "unsorted entries",
+			entries:  []client.ScheduleEntryV2{entry(14, 24), entry(0, 12)},
+			from:     lo.ToPtr(at(0)),
+			to:       lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{{StartAt: at(12), EndAt: at(14)}},
+		},
+		{
+			name:     "entries spilling outside the window",
+			entries:  []client.ScheduleEntryV2{entry(0, 12)},
+			from:     lo.ToPtr(at(2)),
+			to:       lo.ToPtr(at(10)),
+			expected: []scheduleEntryGap{},
+		},
+		{
+			name:     "open window ignores the edges",
+			entries:  []client.ScheduleEntryV2{entry(4, 8), entry(10, 20)},
+			expected: []scheduleEntryGap{{StartAt: at(8), EndAt: at(10)}},
+		},
+		{
+			name:     "no entries in a closed window",
+			from:     lo.ToPtr(at(0)),
+			to:       lo.ToPtr(at(24)),
+			expected: []scheduleEntryGap{{StartAt: at(0), EndAt: at(24)}},
+		},
+		{
+			name:     "no entries in an open window",
+			from:     lo.ToPtr(at(0)),
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scheduleEntryGaps(tc.entries, tc.from, tc.to))
+		})
+	}
+}
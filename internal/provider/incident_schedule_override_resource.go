@@ -0,0 +1,441 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                = &IncidentScheduleOverrideResource{}
+	_ resource.ResourceWithConfigure   = &IncidentScheduleOverrideResource{}
+	_ resource.ResourceWithImportState = &IncidentScheduleOverrideResource{}
+	_ resource.ResourceWithIdentity    = &IncidentScheduleOverrideResource{}
+	_ resource.ResourceWithModifyPlan  = &IncidentScheduleOverrideResource{}
+)
+
+type IncidentScheduleOverrideResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleOverrideResourceModel struct {
+	ID         types.String   `tfsdk:"id"`
+	ScheduleID types.String   `tfsdk:"schedule_id"`
+	RotationID types.String   `tfsdk:"rotation_id"`
+	LayerID    types.String   `tfsdk:"layer_id"`
+	UserID     types.String   `tfsdk:"user_id"`
+	StartAt    types.String   `tfsdk:"start_at"`
+	EndAt      types.String   `tfsdk:"end_at"`
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentScheduleOverrideResource() resource.Resource {
+	return &IncidentScheduleOverrideResource{}
+}
+
+func (r *IncidentScheduleOverrideResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_override"
+}
+
+func (r *IncidentScheduleOverrideResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage a schedule override: planned cover where one user takes over a layer of a
+rotation for a fixed window, such as while the usual responder is on holiday.
+
+The API can't change or remove an override once it's created, so changing any attribute
+creates a new override, and destroying this resource only removes it from state. An
+override that hasn't ended yet stays in place until you remove it from the schedule in
+the dashboard: the plan warns when that would happen.
+
+Once an override has ended it drops out of state, as it can't affect the schedule any
+more. Remove it from your configuration too: the API won't create an override that has
+already ended, so until you do, plans fail asking you to.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SchedulesCreateOverridePayloadV2", "schedule_id"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"rotation_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SchedulesCreateOverridePayloadV2", "rotation_id"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"layer_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SchedulesCreateOverridePayloadV2", "layer_id"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The incident.io ID of the user who covers the layer for the override.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"start_at": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SchedulesCreateOverridePayloadV2", "start_at"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"end_at": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SchedulesCreateOverridePayloadV2", "end_at"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentScheduleOverrideResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+// ModifyPlan catches the two ways an override can go wrong that the API would only
+// report at apply time, or not at all: creating one that has already ended, and
+// replacing or destroying one that will carry on applying regardless.
+func (r *IncidentScheduleOverrideResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var state, plan *IncidentScheduleOverrideResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+
+	// Terraform plans the create half of a replacement with no prior state, so this
+	// covers both. An end_at in the past would make the API reject the override: it's
+	// most likely cover that has already finished and dropped out of state, so say so
+	// instead of failing the apply.
+	if state == nil && plan != nil && scheduleOverrideEnded(plan.EndAt, now) {
+		addScheduleOverrideEndedError(plan.EndAt, &resp.Diagnostics)
+		return
+	}
+
+	// Every attribute forces replacement, so any planned change to an existing override
+	// either replaces or destroys it. Neither removes the original.
+	if state == nil || scheduleOverrideEnded(state.EndAt, now) {
+		return
+	}
+	if plan != nil && !scheduleOverrideChanged(state, plan) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Schedule override will stay in place",
+		fmt.Sprintf("The API can't remove schedule overrides, so override %s (%s to %s) will keep "+
+			"applying after this change. Remove it from the schedule in the dashboard if it's no "+
+			"longer needed.", state.ID.ValueString(), state.StartAt.ValueString(), state.EndAt.ValueString()),
+	)
+}
+
+// scheduleOverrideChanged is true when the plan changes any of the override's
+// configurable attributes.
+func scheduleOverrideChanged(state, plan *IncidentScheduleOverrideResourceModel) bool {
+	return !plan.ScheduleID.Equal(state.ScheduleID) ||
+		!plan.RotationID.Equal(state.RotationID) ||
+		!plan.LayerID.Equal(state.LayerID) ||
+		!plan.UserID.Equal(state.UserID) ||
+		!plan.StartAt.Equal(state.StartAt) ||
+		!plan.EndAt.Equal(state.EndAt)
+}
+
+// addScheduleOverrideEndedError reports an override that can't be created because it
+// has already ended.
+func addScheduleOverrideEndedError(endAt types.String, diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root("end_at"),
+		"Schedule override has already ended",
+		fmt.Sprintf("This override ended at %s, so it can't be created. Finished overrides drop "+
+			"out of state: remove it from your configuration.", endAt.ValueString()),
+	)
+}
+
+// scheduleOverrideEnded is true when endAt is a known timestamp no later than now.
+func scheduleOverrideEnded(endAt types.String, now time.Time) bool {
+	if endAt.IsNull() || endAt.IsUnknown() {
+		return false
+	}
+
+	parsed, err := time.Parse(time.RFC3339, endAt.ValueString())
+	if err != nil {
+		return false
+	}
+
+	return !parsed.After(now)
+}
+
+func (r *IncidentScheduleOverrideResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentScheduleOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The override may have ended since it was planned.
+	if scheduleOverrideEnded(data.EndAt, time.Now()) {
+		addScheduleOverrideEndedError(data.EndAt, &resp.Diagnostics)
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	startAt, err := time.Parse(time.RFC3339, data.StartAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_at"), "Invalid start_at", err.Error())
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, data.EndAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_at"), "Invalid end_at", err.Error())
+		return
+	}
+
+	result, err := r.client.SchedulesV2CreateOverrideWithResponse(ctx, client.SchedulesV2CreateOverrideJSONRequestBody{
+		ScheduleId: data.ScheduleID.ValueString(),
+		RotationId: data.RotationID.ValueString(),
+		LayerId:    data.LayerID.ValueString(),
+		User:       client.UserReferencePayloadV2{Id: data.UserID.ValueStringPointer()},
+		StartAt:    startAt,
+		EndAt:      endAt,
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule override", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a schedule override resource with id=%s", result.JSON201.Override.Id))
+	data = r.buildModel(result.JSON201.Override, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+func (r *IncidentScheduleOverrideResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentScheduleOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A finished override can't affect the schedule any more, and keeping it would mean
+	// a diff to recreate it if it's ever removed upstream. Drop it instead.
+	if scheduleOverrideEnded(data.EndAt, time.Now()) {
+		tflog.Info(ctx, fmt.Sprintf("Schedule override with ID %s ended at %s: removing from state.", data.ID.ValueString(), data.EndAt.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// There's no endpoint to fetch a single override, so find it in the schedule's list.
+	// Imports only know the schedule, so the rotation and layer narrow the search when
+	// we have them.
+	params := client.SchedulesV2ListOverridesParams{
+		ScheduleId: data.ScheduleID.ValueString(),
+	}
+	if data.RotationID.ValueString() != "" {
+		params.RotationId = data.RotationID.ValueStringPointer()
+	}
+	if data.LayerID.ValueString() != "" {
+		params.LayerId = data.LayerID.ValueStringPointer()
+	}
+
+	overrides, err := listScheduleOverrides(ctx, r.client, params)
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Schedule with ID %s not found: removing override %s from state.", data.ScheduleID.ValueString(), data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule override", err)
+		return
+	}
+
+	override, found := lo.Find(overrides, func(override client.ScheduleOverrideV2) bool {
+		return override.Id == data.ID.ValueString()
+	})
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("Schedule override with ID %s not found: removing from state.", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// An import only knows the IDs, so this is the first we know of when it ends.
+	if !override.EndAt.After(time.Now()) {
+		tflog.Info(ctx, fmt.Sprintf("Schedule override with ID %s ended at %s: removing from state.", override.Id, override.EndAt.Format(time.RFC3339)))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data = r.buildModel(override, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+// Update is never called with a change to apply, as every attribute forces replacement.
+func (r *IncidentScheduleOverrideResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentScheduleOverrideResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+// Delete only forgets the override: the API has no way to remove one. ModifyPlan has
+// already warned if the override is still going to apply.
+func (r *IncidentScheduleOverrideResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentScheduleOverrideResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Schedule override with ID %s can't be deleted through the API: removing it from state only.", data.ID.ValueString()))
+}
+
+// ImportState takes "<schedule_id>:<override_id>", since overrides can only be listed
+// by schedule.
+func (r *IncidentScheduleOverrideResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID(ctx, req, ":", &resp.Diagnostics, "schedule_id", "id")
+	scheduleID, overrideID, found := strings.Cut(id, ":")
+	if !found || scheduleID == "" || overrideID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("expected <schedule_id>:<override_id>, got %q.", id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), overrideID)...)
+}
+
+func (r *IncidentScheduleOverrideResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"schedule_id": "The ID of the schedule the override belongs to.",
+		"id":          "The ID of the override.",
+	})
+}
+
+// buildModel converts from the response type to the terraform model/schema type. prior
+// is the plan (create) or prior state (read). Timestamps keep the prior's formatting
+// when they're the same instant, so a config written with an offset doesn't diff
+// against the UTC the API returns.
+func (r *IncidentScheduleOverrideResource) buildModel(override client.ScheduleOverrideV2, prior *IncidentScheduleOverrideResourceModel) *IncidentScheduleOverrideResourceModel {
+	model := &IncidentScheduleOverrideResourceModel{
+		ID:         types.StringValue(override.Id),
+		ScheduleID: types.StringValue(override.ScheduleId),
+		RotationID: types.StringValue(override.RotationId),
+		LayerID:    types.StringValue(override.LayerId),
+		UserID:     types.StringNull(),
+		StartAt:    types.StringValue(override.StartAt.Format(time.RFC3339)),
+		EndAt:      types.StringValue(override.EndAt.Format(time.RFC3339)),
+	}
+	if override.User != nil {
+		model.UserID = types.StringValue(override.User.Id)
+	}
+
+	if prior != nil {
+		model.StartAt = sameInstantOrValue(prior.StartAt, override.StartAt)
+		model.EndAt = sameInstantOrValue(prior.EndAt, override.EndAt)
+		model.Timeouts = prior.Timeouts
+	}
+
+	return model
+}
+
+// sameInstantOrValue returns prior if it's an RFC3339 timestamp for the same instant as
+// value, or value formatted as RFC3339 otherwise.
+func sameInstantOrValue(prior types.String, value time.Time) types.String {
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if parsed, err := time.Parse(time.RFC3339, prior.ValueString()); err == nil && parsed.Equal(value) {
+			return prior
+		}
+	}
+
+	return types.StringValue(value.Format(time.RFC3339))
+}
+
+// listScheduleOverrides returns every override matching params, following the
+// pagination cursor until the last page.
+func listScheduleOverrides(ctx context.Context, apiClient *client.ClientWithResponses, params client.SchedulesV2ListOverridesParams) ([]client.ScheduleOverrideV2, error) {
+	overrides := []client.ScheduleOverrideV2{}
+	params.PageSize = lo.ToPtr(int64(overrideLookupPageSize))
+
+	for {
+		result, err := apiClient.SchedulesV2ListOverridesWithResponse(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", result.Status())
+		}
+
+		overrides = append(overrides, result.JSON200.Overrides...)
+
+		if result.JSON200.PaginationMeta == nil || result.JSON200.PaginationMeta.After == nil {
+			break
+		}
+		params.After = result.JSON200.PaginationMeta.After
+	}
+
+	return overrides, nil
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentScheduleOverrideResource(t *testing.T) {
+	// Skip before looking up a user, which needs a live API call.
+	testAccPreCheck(t)
+
+	userID := testAccMaintenanceWindowLeadUserID(t)
+	startAt := testAccNow(t).UTC().Truncate(time.Hour).Add(30 * 24 * time.Hour)
+	endAt := startAt.Add(48 * time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentScheduleOverrideResourceConfig(userID, startAt, endAt),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("incident_schedule_override.example", "id"),
+					resource.TestCheckResourceAttrPair(
+						"incident_schedule_override.example", "schedule_id",
+						"incident_schedule.example", "id"),
+					resource.TestCheckResourceAttr("incident_schedule_override.example", "rotation_id", "rota-primary"),
+					resource.TestCheckResourceAttr("incident_schedule_override.example", "layer_id", "rota-primary-layer-one"),
+					resource.TestCheckResourceAttr("incident_schedule_override.example", "user_id", userID),
+					resource.TestCheckResourceAttr("incident_schedule_override.example", "start_at", startAt.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("incident_schedule_override.example", "end_at", endAt.Format(time.RFC3339)),
+					resource.TestCheckResourceAttr("data.incident_schedule_overrides.example", "overrides.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_schedule_overrides.example", "overrides.0.id",
+						"incident_schedule_override.example", "id"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_schedule_override.example",
+				ImportState:       true,
+				ImportStateIdFunc: testAccScheduleOverrideImportID("incident_schedule_override.example"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccScheduleOverrideImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["schedule_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func testAccIncidentScheduleOverrideResourceConfig(userID string, startAt, endAt time.Time) string {
+	return testRunTemplate("incident_schedule_override", `
+resource "incident_schedule" "example" {
+  name     = {{ stableSuffix "Override schedule" | quote }}
+  timezone = "Europe/London"
+  rotations = [
+    {
+      id   = "rota-primary"
+      name = "Primary"
+      versions = [
+        {
+          handover_start_at = "2024-04-26T16:00:00Z"
+          handovers = [
+            {
+              interval      = 1
+              interval_type = "weekly"
+            }
+          ]
+          users = [{{ quote .UserID }}]
+          layers = [
+            {
+              id   = "rota-primary-layer-one"
+              name = "Layer One"
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+
+resource "incident_schedule_override" "example" {
+  schedule_id = incident_schedule.example.id
+  rotation_id = "rota-primary"
+  layer_id    = "rota-primary-layer-one"
+  user_id     = {{ quote .UserID }}
+  start_at    = {{ quote .StartAt }}
+  end_at      = {{ quote .EndAt }}
+}
+
+data "incident_schedule_overrides" "example" {
+  schedule_id = incident_schedule.example.id
+  from        = {{ quote .StartAt }}
+
+  depends_on = [incident_schedule_override.example]
+}
+`, struct {
+		UserID  string
+		StartAt string
+		EndAt   string
+	}{
+		UserID:  userID,
+		StartAt: startAt.Format(time.RFC3339),
+		EndAt:   endAt.Format(time.RFC3339),
+	})
+}
+
+// TestIncidentScheduleOverrideResourceEnded checks an override that has ended drops out of
+// state, whether or not the API still lists it, and that one that has already ended can't
+// be created.
+func TestIncidentScheduleOverrideResourceEnded(t *testing.T) {
+	const override = `{
+  "id": "01OVERRIDE",
+  "schedule_id": "01SCHEDULE",
+  "rotation_id": "rota-primary",
+  "layer_id": "rota-primary-layer-one",
+  "user": {"id": "01USER", "name": "Lisa", "role": "responder"},
+  "start_at": "2025-01-01T09:00:00Z",
+  "end_at": "2025-01-03T09:00:00Z",
+  "created_at": "2024-12-01T00:00:00Z",
+  "updated_at": "2024-12-01T00:00:00Z"
+}`
+
+	ctx := context.Background()
+	setup := func(t *testing.T, overrides string) (tfprotov6.ProviderServer, func(id tftypes.Value) *tfprotov6.DynamicValue, tftypes.Type) {
+		api := http.NewServeMux()
+		api.HandleFunc("GET /v2/schedule_overrides", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"overrides": [%s], "pagination_meta": {"page_size": 250}}`, overrides)
+		})
+		api.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+			t.Errorf("unexpected request to %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		})
+
+		providerServer, diags := testProviderServer(t, api, nil)
+		if len(diags) > 0 {
+			t.Fatalf("configuring the provider: %+v", diags)
+		}
+
+		schemas, err := providerServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil {
+			t.Fatalf("getting the provider schema: %v", err)
+		}
+		objectType := schemas.ResourceSchemas["incident_schedule_override"].ValueType()
+		value := func(id tftypes.Value) *tfprotov6.DynamicValue {
+			dynamicValue, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":          id,
+				"schedule_id": tftypes.NewValue(tftypes.String, "01SCHEDULE"),
+				"rotation_id": tftypes.NewValue(tftypes.String, "rota-primary"),
+				"layer_id":    tftypes.NewValue(tftypes.String, "rota-primary-layer-one"),
+				"user_id":     tftypes.NewValue(tftypes.String, "01USER"),
+				"start_at":    tftypes.NewValue(tftypes.String, "2025-01-01T09:00:00Z"),
+				"end_at":      tftypes.NewValue(tftypes.String, "2025-01-03T09:00:00Z"),
+				"timeouts":    tftypes.NewValue(objectType.(tftypes.Object).AttributeTypes["timeouts"], nil),
+			}))
+			if err != nil {
+				t.Fatalf("building a value: %v", err)
+			}
+			return &dynamicValue
+		}
+
+		return providerServer, value, objectType
+	}
+
+	for _, tc := range []struct {
+		name      string
+		overrides string
+	}{
+		{name: "still listed", overrides: override},
+		{name: "no longer listed", overrides: ""},
+	} {
+		t.Run("drops out of state when "+tc.name, func(t *testing.T) {
+			providerServer, value, objectType := setup(t, tc.overrides)
+
+			identities, err := providerServer.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+			if err != nil {
+				t.Fatalf("getting the identity schemas: %v", err)
+			}
+			identityType := identities.IdentitySchemas["incident_schedule_override"].ValueType()
+			identity, err := tfprotov6.NewDynamicValue(identityType, tftypes.NewValue(identityType, map[string]tftypes.Value{
+				"schedule_id": tftypes.NewValue(tftypes.String, "01SCHEDULE"),
+				"id":          tftypes.NewValue(tftypes.String, "01OVERRIDE"),
+			}))
+			if err != nil {
+				t.Fatalf("building the identity: %v", err)
+			}
+
+			read, err := providerServer.ReadResource(ctx, &tfprotov6.ReadResourceRequest{
+				TypeName:        "incident_schedule_override",
+				CurrentState:    value(tftypes.NewValue(tftypes.String, "01OVERRIDE")),
+				CurrentIdentity: &tfprotov6.ResourceIdentityData{IdentityData: &identity},
+			})
+			if err != nil {
+				t.Fatalf("reading the override: %v", err)
+			}
+			if len(read.Diagnostics) > 0 {
+				t.Fatalf("reading the override: %s: %s", read.Diagnostics[0].Summary, read.Diagnostics[0].Detail)
+			}
+
+			newState, err := read.NewState.Unmarshal(objectType)
+			if err != nil {
+				t.Fatalf("reading the new state: %v", err)
+			}
+			assert.True(t, newState.IsNull(), "expected the ended override to be removed from state")
+		})
+	}
+
+	t.Run("can't be created", func(t *testing.T) {
+		providerServer, value, objectType := setup(t, "")
+
+		priorState, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, nil))
+		if err != nil {
+			t.Fatalf("building the prior state: %v", err)
+		}
+		plan, err := providerServer.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+			TypeName:         "incident_schedule_override",
+			PriorState:       &priorState,
+			ProposedNewState: value(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			Config:           value(tftypes.NewValue(tftypes.String, nil)),
+		})
+		if err != nil {
+			t.Fatalf("planning the override: %v", err)
+		}
+		if assert.Len(t, plan.Diagnostics, 1) {
+			assert.Equal(t, tfprotov6.DiagnosticSeverityError, plan.Diagnostics[0].Severity)
+			assert.Equal(t, "Schedule override has already ended", plan.Diagnostics[0].Summary)
+		}
+
+		// Or applied, if it ended after it was planned.
+		apply, err := providerServer.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+			TypeName:     "incident_schedule_override",
+			PriorState:   &priorState,
+			PlannedState: value(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			Config:       value(tftypes.NewValue(tftypes.String, nil)),
+		})
+		if err != nil {
+			t.Fatalf("applying the override: %v", err)
+		}
+		if assert.Len(t, apply.Diagnostics, 1) {
+			assert.Equal(t, "Schedule override has already ended", apply.Diagnostics[0].Summary)
+		}
+	})
+}
+
+func TestScheduleOverrideEnded(t *testing.T) {
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		endAt    types.String
+		expected bool
+	}{
+		{
+			name:     "ends in the future",
+			endAt:    types.StringValue("2026-06-02T12:00:00Z"),
+			expected: false,
+		},
+		{
+			name:     "ended in the past",
+			endAt:    types.StringValue("2026-05-31T12:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "ends right now",
+			endAt:    types.StringValue("2026-06-01T12:00:00Z"),
+			expected: true,
+		},
+		{
+			name:     "offset is respected",
+			endAt:    types.StringValue("2026-06-01T13:30:00+01:00"),
+			expected: false,
+		},
+		{
+			name:     "unknown",
+			endAt:    types.StringUnknown(),
+			expected: false,
+		},
+		{
+			name:     "null",
+			endAt:    types.StringNull(),
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scheduleOverrideEnded(tc.endAt, now))
+		})
+	}
+}
+
+func TestScheduleOverrideOverlaps(t *testing.T) {
+	at := func(day int) *time.Time {
+		return lo.ToPtr(time.Date(2026, 6, day, 0, 0, 0, 0, time.UTC))
+	}
+	override := client.ScheduleOverrideV2{
+		StartAt: *at(10),
+		EndAt:   *at(12),
+	}
+
+	testCases := []struct {
+		name     string
+		from, to *time.Time
+		expected bool
+	}{
+		{name: "no window", expected: true},
+		{name: "window contains override", from: at(1), to: at(20), expected: true},
+		{name: "override starts inside window", from: at(1), to: at(11), expected: true},
+		{name: "override ends inside window", from: at(11), to: at(20), expected: true},
+		{name: "window before override", from: at(1), to: at(10), expected: false},
+		{name: "window after override", from: at(12), to: at(20), expected: false},
+		{name: "open-ended from", from: at(11), expected: true},
+		{name: "open-ended to", to: at(9), expected: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, scheduleOverrideOverlaps(override, tc.from, tc.to))
+		})
+	}
+}
+
+func TestSameInstantOrValue(t *testing.T) {
+	value := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, types.StringValue("2026-06-01T13:00:00+01:00"),
+		sameInstantOrValue(types.StringValue("2026-06-01T13:00:00+01:00"), value), "keeps the prior when it's the same instant")
+	assert.Equal(t, types.StringValue("2026-06-01T12:00:00Z"),
+		sameInstantOrValue(types.StringValue("2026-06-01T14:00:00+01:00"), value), "uses the API value when the instant differs")
+	assert.Equal(t, types.StringValue("2026-06-01T12:00:00Z"),
+		sameInstantOrValue(types.StringNull(), value), "uses the API value without a prior")
+}
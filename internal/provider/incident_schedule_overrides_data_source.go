@@ -0,0 +1,212 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentScheduleOverridesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentScheduleOverridesDataSource{}
+)
+
+func NewIncidentScheduleOverridesDataSource() datasource.DataSource {
+	return &IncidentScheduleOverridesDataSource{}
+}
+
+type IncidentScheduleOverridesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleOverridesDataSourceModel struct {
+	ScheduleID types.String                                   `tfsdk:"schedule_id"`
+	RotationID types.String                                   `tfsdk:"rotation_id"`
+	LayerID    types.String                                   `tfsdk:"layer_id"`
+	From       types.String                                   `tfsdk:"from"`
+	To         types.String                                   `tfsdk:"to"`
+	Overrides  []IncidentScheduleOverridesDataSourceItemModel `tfsdk:"overrides"`
+}
+
+type IncidentScheduleOverridesDataSourceItemModel struct {
+	ID         types.String `tfsdk:"id"`
+	ScheduleID types.String `tfsdk:"schedule_id"`
+	RotationID types.String `tfsdk:"rotation_id"`
+	LayerID    types.String `tfsdk:"layer_id"`
+	UserID     types.String `tfsdk:"user_id"`
+	StartAt    types.String `tfsdk:"start_at"`
+	EndAt      types.String `tfsdk:"end_at"`
+}
+
+func (d *IncidentScheduleOverridesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentScheduleOverridesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_overrides"
+}
+
+func (d *IncidentScheduleOverridesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentScheduleOverridesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var from, to *time.Time
+	if !data.From.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.From.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("from"), "Invalid from", err.Error())
+			return
+		}
+		from = &parsed
+	}
+	if !data.To.IsNull() {
+		parsed, err := time.Parse(time.RFC3339, data.To.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("to"), "Invalid to", err.Error())
+			return
+		}
+		to = &parsed
+	}
+
+	overrides, err := listScheduleOverrides(ctx, d.client, client.SchedulesV2ListOverridesParams{
+		ScheduleId: data.ScheduleID.ValueString(),
+		RotationId: data.RotationID.ValueStringPointer(),
+		LayerId:    data.LayerID.ValueStringPointer(),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list schedule overrides", err)
+		return
+	}
+
+	data.Overrides = []IncidentScheduleOverridesDataSourceItemModel{}
+	for _, override := range overrides {
+		if !scheduleOverrideOverlaps(override, from, to) {
+			continue
+		}
+
+		item := IncidentScheduleOverridesDataSourceItemModel{
+			ID:         types.StringValue(override.Id),
+			ScheduleID: types.StringValue(override.ScheduleId),
+			RotationID: types.StringValue(override.RotationId),
+			LayerID:    types.StringValue(override.LayerId),
+			UserID:     types.StringNull(),
+			StartAt:    types.StringValue(override.StartAt.Format(time.RFC3339)),
+			EndAt:      types.StringValue(override.EndAt.Format(time.RFC3339)),
+		}
+		if override.User != nil {
+			item.UserID = types.StringValue(override.User.Id)
+		}
+
+		data.Overrides = append(data.Overrides, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// scheduleOverrideOverlaps is true when any part of the override falls within the window
+// from from to to. Either end of the window can be nil, to leave it open.
+func scheduleOverrideOverlaps(override client.ScheduleOverrideV2, from, to *time.Time) bool {
+	if from != nil && !override.EndAt.After(*from) {
+		return false
+	}
+	if to != nil && !override.StartAt.Before(*to) {
+		return false
+	}
+
+	return true
+}
+
+func (d *IncidentScheduleOverridesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the overrides on a schedule, optionally narrowed to a rotation, a layer, or a window of time.",
+		Attributes: map[string]schema.Attribute{
+			"schedule_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the schedule to list overrides for.",
+			},
+			"rotation_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only return overrides on this rotation.",
+			},
+			"layer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only return overrides on this layer.",
+			},
+			"from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only return overrides that end after this RFC3339 timestamp.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only return overrides that start before this RFC3339 timestamp.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"overrides": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The overrides matching the filters, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "id"),
+						},
+						"schedule_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "schedule_id"),
+						},
+						"rotation_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "rotation_id"),
+						},
+						"layer_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "layer_id"),
+						},
+						"user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The incident.io ID of the user who covers the layer for the override.",
+						},
+						"start_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "start_at"),
+						},
+						"end_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleOverrideV2", "end_at"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                = &IncidentScheduleReplicaResource{}
+	_ resource.ResourceWithConfigure   = &IncidentScheduleReplicaResource{}
+	_ resource.ResourceWithImportState = &IncidentScheduleReplicaResource{}
+	_ resource.ResourceWithIdentity    = &IncidentScheduleReplicaResource{}
+)
+
+type IncidentScheduleReplicaResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentScheduleReplicaResourceModel struct {
+	ID                    types.String                         `tfsdk:"id"`
+	ScheduleID            types.String                         `tfsdk:"schedule_id"`
+	ReplicaProvider       types.String                         `tfsdk:"replica_provider"`
+	ReplicaProviderID     types.String                         `tfsdk:"replica_provider_id"`
+	ReplicaFallbackUserID types.String                         `tfsdk:"replica_fallback_user_id"`
+	MirrorWindowDays      types.Int64                          `tfsdk:"mirror_window_days"`
+	Sources               []IncidentScheduleReplicaSourceModel `tfsdk:"sources"`
+	LastSyncedAt          types.String                         `tfsdk:"last_synced_at"`
+	LastSyncError         types.String                         `tfsdk:"last_sync_error"`
+	Timeouts              timeouts.Value                       `tfsdk:"timeouts"`
+}
+
+type IncidentScheduleReplicaSourceModel struct {
+	RotationID types.String `tfsdk:"rotation_id"`
+	LayerID    types.String `tfsdk:"layer_id"`
+}
+
+func NewIncidentScheduleReplicaResource() resource.Resource {
+	return &IncidentScheduleReplicaResource{}
+}
+
+func (r *IncidentScheduleReplicaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule_replica"
+}
+
+func (r *IncidentScheduleReplicaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Replicas can't be updated in place, so every configurable attribute forces a
+	// replacement.
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage a schedule replica, which mirrors layers of an incident.io schedule into a
+schedule in another provider such as PagerDuty or Opsgenie. This keeps the other provider's
+schedule up to date while you migrate, so anything still paging through it reaches whoever
+is on call in incident.io.
+
+Replicas can't be changed once created: changing any attribute replaces the replica.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaV2", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"schedule_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaV2", "schedule_id"),
+				PlanModifiers:       requiresReplace,
+			},
+			"replica_provider": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: EnumValuesDescription("ScheduleReplicaCreatePayloadV2", "replica_provider"),
+				PlanModifiers:       requiresReplace,
+			},
+			"replica_provider_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaCreatePayloadV2", "replica_provider_id"),
+				PlanModifiers:       requiresReplace,
+			},
+			"replica_fallback_user_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaCreatePayloadV2", "replica_fallback_user_id"),
+				PlanModifiers:       requiresReplace,
+			},
+			"mirror_window_days": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaCreatePayloadV2", "mirror_window_days"),
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+					int64planmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"sources": schema.SetNestedAttribute{
+				Required:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaCreatePayloadV2", "sources"),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rotation_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleReplicaSourceV2", "rotation_id"),
+						},
+						"layer_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: apischema.Docstring("ScheduleReplicaSourceV2", "layer_id"),
+						},
+					},
+				},
+			},
+			"last_synced_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaV2", "last_synced_at"),
+			},
+			"last_sync_error": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("ScheduleReplicaV2", "last_sync_error"),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentScheduleReplicaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client.Client
+}
+
+func (r *IncidentScheduleReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentScheduleReplicaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	sources := []client.ScheduleReplicaSourceV2{}
+	for _, source := range data.Sources {
+		sources = append(sources, client.ScheduleReplicaSourceV2{
+			RotationId: source.RotationID.ValueString(),
+			LayerId:    source.LayerID.ValueString(),
+		})
+	}
+
+	payload := client.ScheduleReplicaCreatePayloadV2{
+		ReplicaProvider:       client.ScheduleReplicaCreatePayloadV2ReplicaProvider(data.ReplicaProvider.ValueString()),
+		ReplicaProviderId:     data.ReplicaProviderID.ValueString(),
+		ReplicaFallbackUserId: data.ReplicaFallbackUserID.ValueString(),
+		Sources:               sources,
+	}
+	if !data.MirrorWindowDays.IsNull() && !data.MirrorWindowDays.IsUnknown() {
+		payload.MirrorWindowDays = data.MirrorWindowDays.ValueInt64Pointer()
+	}
+
+	result, err := r.client.SchedulesV2CreateScheduleReplicaWithResponse(ctx, data.ScheduleID.ValueString(), client.SchedulesV2CreateScheduleReplicaJSONRequestBody{
+		ScheduleReplica: payload,
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule replica", err)
+		return
+	}
+
+	// Replicas aren't a managed resource type in the API yet, so unlike the schedule they
+	// mirror there's nothing to claim: the dashboard won't mark them as managed by
+	// Terraform. Claim them here and in ImportState once the API accepts them.
+	tflog.Trace(ctx, fmt.Sprintf("created a schedule replica resource with id=%s", result.JSON201.ScheduleReplica.Id))
+	model := r.buildModel(result.JSON201.ScheduleReplica)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+func (r *IncidentScheduleReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentScheduleReplicaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.SchedulesV2ShowScheduleReplicaWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		httpErr := client.HTTPError{}
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			tflog.Warn(ctx, fmt.Sprintf("Schedule replica with ID %s not found: removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule replica", err)
+		return
+	}
+
+	model := r.buildModel(result.JSON200.ScheduleReplica)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+// Update only ever refreshes the computed sync status, as every configurable attribute
+// forces replacement.
+func (r *IncidentScheduleReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentScheduleReplicaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.SchedulesV2ShowScheduleReplicaWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule replica", err)
+		return
+	}
+
+	model := r.buildModel(result.JSON200.ScheduleReplica)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
+}
+
+func (r *IncidentScheduleReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentScheduleReplicaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.client.SchedulesV2DestroyScheduleReplicaWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule replica", err)
+		return
+	}
+}
+
+// ImportState takes "<schedule_id>:<replica_id>", since a replica is only addressable
+// through the schedule it mirrors.
+func (r *IncidentScheduleReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID(ctx, req, ":", &resp.Diagnostics, "schedule_id", "id")
+	scheduleID, replicaID, found := strings.Cut(id, ":")
+	if !found || scheduleID == "" || replicaID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("expected <schedule_id>:<replica_id>, got %q.", id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule_id"), scheduleID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), replicaID)...)
+}
+
+func (r *IncidentScheduleReplicaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"schedule_id": "The ID of the schedule the replica belongs to.",
+		"id":          "The ID of the replica.",
+	})
+}
+
+// buildModel converts from the response type to the terraform model/schema type.
+func (r *IncidentScheduleReplicaResource) buildModel(replica client.ScheduleReplicaV2) *IncidentScheduleReplicaResourceModel {
+	model := &IncidentScheduleReplicaResourceModel{
+		ID:                    types.StringValue(replica.Id),
+		ScheduleID:            types.StringValue(replica.ScheduleId),
+		ReplicaProvider:       types.StringValue(string(replica.ReplicaProvider)),
+		ReplicaProviderID:     types.StringValue(replica.ReplicaProviderId),
+		ReplicaFallbackUserID: types.StringValue(replica.ReplicaFallbackUserId),
+		MirrorWindowDays:      types.Int64PointerValue(replica.MirrorWindowDays),
+		Sources:               []IncidentScheduleReplicaSourceModel{},
+		LastSyncedAt:          types.StringNull(),
+		LastSyncError:         types.StringPointerValue(replica.LastSyncError),
+	}
+	for _, source := range replica.Sources {
+		model.Sources = append(model.Sources, IncidentScheduleReplicaSourceModel{
+			RotationID: types.StringValue(source.RotationId),
+			LayerID:    types.StringValue(source.LayerId),
+		})
+	}
+	if replica.LastSyncedAt != nil {
+		model.LastSyncedAt = types.StringValue(replica.LastSyncedAt.Format(time.RFC3339))
+	}
+
+	return model
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccIncidentScheduleReplicaResource tests creating and importing a schedule replica.
+//
+// NOTE: This test requires a PagerDuty integration, which is not available in CI. Set
+// TF_ACC_SCHEDULE_REPLICA_PROVIDER_ID to a PagerDuty schedule ID and
+// TF_ACC_SCHEDULE_REPLICA_FALLBACK_USER_ID to a PagerDuty user ID to run it locally.
+func TestAccIncidentScheduleReplicaResource(t *testing.T) {
+	providerID := os.Getenv("TF_ACC_SCHEDULE_REPLICA_PROVIDER_ID")
+	fallbackUserID := os.Getenv("TF_ACC_SCHEDULE_REPLICA_FALLBACK_USER_ID")
+	if providerID == "" || fallbackUserID == "" {
+		t.Skip("TF_ACC_SCHEDULE_REPLICA_PROVIDER_ID or TF_ACC_SCHEDULE_REPLICA_FALLBACK_USER_ID is not set: skipping test that requires a PagerDuty integration")
+	}
+
+	// Skip before looking up a user, which needs a live API call.
+	testAccPreCheck(t)
+
+	userID := testAccMaintenanceWindowLeadUserID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentScheduleReplicaResourceConfig(userID, providerID, fallbackUserID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("incident_schedule_replica.example", "id"),
+					resource.TestCheckResourceAttrPair(
+						"incident_schedule_replica.example", "schedule_id",
+						"incident_schedule.example", "id"),
+					resource.TestCheckResourceAttr("incident_schedule_replica.example", "replica_provider", "pagerduty"),
+					resource.TestCheckResourceAttr("incident_schedule_replica.example", "replica_provider_id", providerID),
+					resource.TestCheckResourceAttr("incident_schedule_replica.example", "replica_fallback_user_id", fallbackUserID),
+					resource.TestCheckResourceAttr("incident_schedule_replica.example", "mirror_window_days", "14"),
+					resource.TestCheckResourceAttr("incident_schedule_replica.example", "sources.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("incident_schedule_replica.example", "sources.*", map[string]string{
+						"rotation_id": "rota-primary",
+						"layer_id":    "rota-primary-layer-one",
+					}),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_schedule_replica.example",
+				ImportState:       true,
+				ImportStateIdFunc: testAccScheduleReplicaImportID("incident_schedule_replica.example"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccScheduleReplicaImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["schedule_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func testAccIncidentScheduleReplicaResourceConfig(userID, providerID, fallbackUserID string) string {
+	return testRunTemplate("incident_schedule_replica", `
+resource "incident_schedule" "example" {
+  name     = {{ stableSuffix "Replica schedule" | quote }}
+  timezone = "Europe/London"
+  rotations = [
+    {
+      id   = "rota-primary"
+      name = "Primary"
+      versions = [
+        {
+          handover_start_at = "2024-04-26T16:00:00Z"
+          handovers = [
+            {
+              interval      = 1
+              interval_type = "weekly"
+            }
+          ]
+          users = [{{ quote .UserID }}]
+          layers = [
+            {
+              id   = "rota-primary-layer-one"
+              name = "Layer One"
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}
+
+resource "incident_schedule_replica" "example" {
+  schedule_id              = incident_schedule.example.id
+  replica_provider         = "pagerduty"
+  replica_provider_id      = {{ quote .ProviderID }}
+  replica_fallback_user_id = {{ quote .FallbackUserID }}
+  mirror_window_days       = 14
+
+  sources = [
+    {
+      rotation_id = "rota-primary"
+      layer_id    = "rota-primary-layer-one"
+    },
+  ]
+}
+`, struct {
+		UserID         string
+		ProviderID     string
+		FallbackUserID string
+	}{
+		UserID:         userID,
+		ProviderID:     providerID,
+		FallbackUserID: fallbackUserID,
+	})
+}
@@ -8,7 +8,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,6 +26,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentScheduleResource{}
 	_ resource.ResourceWithImportState = &IncidentScheduleResource{}
+	_ resource.ResourceWithIdentity    = &IncidentScheduleResource{}
 )
 
 type IncidentScheduleResource struct {
@@ -37,6 +38,10 @@ func NewIncidentScheduleResource() resource.Resource {
 	return &IncidentScheduleResource{}
 }
 
+func NewIncidentScheduleListResource() list.ListResource {
+	return newIDListResource(NewIncidentScheduleResource, listScheduleResources)
+}
+
 func (r *IncidentScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_schedule"
 }
@@ -162,6 +167,9 @@ func (r *IncidentScheduleResource) Schema(ctx context.Context, req resource.Sche
 				Required: true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -207,9 +215,12 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	rotationArray, err := buildScheduleCreatePayload(data, resp)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule", err)
 		return
 	}
 
@@ -241,13 +252,14 @@ func (r *IncidentScheduleResource) Create(ctx context.Context, req resource.Crea
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident schedule resource with id=%s", result.JSON201.Schedule.Id))
 	data = r.buildModel(result.JSON201.Schedule, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -257,6 +269,9 @@ func (r *IncidentScheduleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.SchedulesV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -266,12 +281,13 @@ func (r *IncidentScheduleResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.Schedule, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -281,9 +297,12 @@ func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
-	rotationArray, err := buildScheduleUpdatePayload(data, resp)
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	rotationArray, err := buildScheduleUpdatePayload(data, &resp.Diagnostics)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule", err)
 		return
 	}
 
@@ -315,12 +334,13 @@ func (r *IncidentScheduleResource) Update(ctx context.Context, req resource.Upda
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule", err)
 		return
 	}
 
 	data = r.buildModel(result.JSON200.Schedule, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -330,16 +350,27 @@ func (r *IncidentScheduleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.SchedulesV2DestroyWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schedule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule", err)
 		return
 	}
 }
 
 func (r *IncidentScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeSchedule, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeSchedule, r.terraformVersion)
+}
+
+func (r *IncidentScheduleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func buildScheduleCreatePayload(data *models.IncidentScheduleResourceModelV2, resp *resource.CreateResponse) ([]client.ScheduleRotationCreatePayloadV2, error) {
@@ -388,7 +419,7 @@ func buildScheduleCreatePayload(data *models.IncidentScheduleResourceModelV2, re
 	return rotationArray, nil
 }
 
-func buildScheduleUpdatePayload(data *models.IncidentScheduleResourceModelV2, resp *resource.UpdateResponse) ([]client.ScheduleRotationUpdatePayloadV2, error) {
+func buildScheduleUpdatePayload(data *models.IncidentScheduleResourceModelV2, diagnostics *diag.Diagnostics) ([]client.ScheduleRotationUpdatePayloadV2, error) {
 	rotationArray := make([]client.ScheduleRotationUpdatePayloadV2, 0, len(data.Rotations))
 	for _, rotation := range data.Rotations {
 		for _, version := range rotation.Versions {
@@ -412,11 +443,11 @@ func buildScheduleUpdatePayload(data *models.IncidentScheduleResourceModelV2, re
 
 			handoverStartAt, err := time.Parse(time.RFC3339, version.HandoverStartAt.ValueString())
 			if err != nil {
-				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, handover start in invalid format: %s", err))
+				diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule, handover start in invalid format: %s", err))
 				return nil, err
 			}
 
-			effectiveFrom := buildEffectiveFrom(resp.Diagnostics, version.EffectiveFrom)
+			effectiveFrom := buildEffectiveFrom(*diagnostics, version.EffectiveFrom)
 			handovers := buildHandoversArray(version.Handovers)
 			users := buildUsersArray(version.Users)
 
@@ -528,6 +559,7 @@ func (r *IncidentScheduleResource) buildModel(schedule client.ScheduleV2, plan *
 		Timezone:             types.StringValue(schedule.Timezone),
 		HolidaysPublicConfig: holidaysPublicConfig,
 		TeamIDs:              teamIDsSet,
+		Timeouts:             plan.Timeouts,
 		Rotations: lo.Map(rotationNames, func(rotation RotationName, _ int) models.RotationV2 {
 			newRotation := models.RotationV2{
 				ID:   types.StringValue(rotation.ID),
@@ -602,3 +634,38 @@ func buildScheduleHolidaysPublicConfig(data *models.IncidentScheduleResourceMode
 		CountryCodes: countryCodes,
 	}
 }
+
+// listScheduleResources lists all schedules for `terraform query`, a page at a time.
+func listScheduleResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	var (
+		after  *string
+		listed = []listedResource{}
+	)
+
+	for {
+		result, err := apiClient.SchedulesV2ListWithResponse(ctx, &client.SchedulesV2ListParams{
+			PageSize: lo.ToPtr(int64(listResourcePageSize)),
+			After:    after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response listing schedules: %s", result.Status())
+		}
+
+		for _, item := range result.JSON200.Schedules {
+			listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+		}
+
+		after = nil
+		if result.JSON200.PaginationMeta != nil {
+			after = result.JSON200.PaginationMeta.After
+		}
+		if after == nil {
+			break
+		}
+	}
+
+	return listed, nil
+}
@@ -48,7 +48,7 @@ func TestAccIncidentScheduleResource(t *testing.T) {
 							{
 								Id:              "rota-primary",
 								HandoverStartAt: time.Date(2024, 4, 26, 16, 0, 0, 0, time.UTC),
-								EffectiveFrom:   lo.ToPtr(time.Now().Add(time.Hour * 24).UTC()),
+								EffectiveFrom:   lo.ToPtr(testAccNow(t).Add(time.Hour * 24).UTC()),
 								Name:            "Rota",
 								Handovers: []client.ScheduleRotationHandoverV2{
 									{
@@ -146,7 +146,7 @@ func TestAccIncidentScheduleResourceTimezoneUpdate(t *testing.T) {
 
 func TestAccIncidentScheduleResourceRotationUpdates(t *testing.T) {
 	var (
-		effectiveFrom   = time.Now().Add(24 * time.Hour).UTC()
+		effectiveFrom   = testAccNow(t).Add(24 * time.Hour).UTC()
 		handoverStartAt = time.Date(2024, 4, 26, 16, 0, 0, 0, time.UTC)
 	)
 
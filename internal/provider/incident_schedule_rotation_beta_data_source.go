@@ -191,19 +191,18 @@ func (d *IncidentScheduleRotationBetaDataSource) Read(ctx context.Context, req d
 		result, err := d.client.SchedulesV3ShowRotationWithResponse(ctx,
 			data.ScheduleID.ValueString(), data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read schedule rotation", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule rotation", err)
 			return
 		}
 		if result.JSON200 == nil {
-			resp.Diagnostics.AddError("Unable to read schedule rotation",
-				fmt.Sprintf("unexpected response: %s", result.Status()))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule rotation", fmt.Errorf("unexpected response: %s", result.Status()))
 			return
 		}
 		rotation = &result.JSON200.Rotation
 	case !data.Name.IsNull():
 		got, err := d.findByName(ctx, data.ScheduleID.ValueString(), data.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Unable to read schedule rotation by name", err.Error())
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule rotation by name", err)
 			return
 		}
 		rotation = got
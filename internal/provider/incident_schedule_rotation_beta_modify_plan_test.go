@@ -145,6 +145,7 @@ func rotationValue(t *testing.T, scheduleID string, concurrentShifts int64) tfty
 		// no layer is lost.
 		"rollout":        tftypes.NewValue(tftypes.String, nil),
 		"effective_from": tftypes.NewValue(tftypes.String, nil),
+		"timeouts":       tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
 	})
 }
 
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -29,6 +30,7 @@ var (
 	_ resource.Resource                   = &IncidentScheduleRotationBetaResource{}
 	_ resource.ResourceWithConfigure      = &IncidentScheduleRotationBetaResource{}
 	_ resource.ResourceWithImportState    = &IncidentScheduleRotationBetaResource{}
+	_ resource.ResourceWithIdentity       = &IncidentScheduleRotationBetaResource{}
 	_ resource.ResourceWithModifyPlan     = &IncidentScheduleRotationBetaResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentScheduleRotationBetaResource{}
 )
@@ -59,6 +61,7 @@ type IncidentScheduleRotationBetaModel struct {
 	SchedulingMode        types.String                                `tfsdk:"scheduling_mode"`
 	Rollout               types.String                                `tfsdk:"rollout"`
 	EffectiveFrom         timetypes.RFC3339                           `tfsdk:"effective_from"`
+	Timeouts              timeouts.Value                              `tfsdk:"timeouts"`
 }
 
 type IncidentScheduleRotationBetaHandover struct {
@@ -76,7 +79,7 @@ func (r *IncidentScheduleRotationBetaResource) Metadata(_ context.Context, req r
 	resp.TypeName = req.ProviderTypeName + "_schedule_rotation_beta"
 }
 
-func (r *IncidentScheduleRotationBetaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IncidentScheduleRotationBetaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: `Manage a rotation on an on-call schedule.
 
@@ -250,6 +253,9 @@ See ` + "`incident_schedule_beta`" + ` for how the two differ and how to migrate
 				MarkdownDescription: apischema.Docstring("ScheduleRotationV3", "effective_from"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -747,12 +753,11 @@ func (r *IncidentScheduleRotationBetaResource) planEffectiveFrom(ctx context.Con
 			Rotation: rotationUpdatePayload(plan, startsAt),
 		})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to preview schedule rotation rollout", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.Plan.Schema, "Client Error", "Unable to preview schedule rotation rollout", err)
 		return
 	}
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to preview schedule rotation rollout",
-			fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.Plan.Schema, "Client Error", "Unable to preview schedule rotation rollout", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
@@ -1014,6 +1019,9 @@ func (r *IncidentScheduleRotationBetaResource) Create(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	startsAt, diags := data.FirstIntervalStartsAt.ValueRFC3339Time()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1040,17 +1048,17 @@ func (r *IncidentScheduleRotationBetaResource) Create(ctx context.Context, req r
 	result, err := r.client.SchedulesV3CreateRotationWithResponse(ctx, data.ScheduleID.ValueString(),
 		client.SchedulesV3CreateRotationJSONRequestBody{Rotation: payload})
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to create schedule rotation", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule rotation", err)
 		return
 	}
 	if result.JSON201 == nil {
-		resp.Diagnostics.AddError("Unable to create schedule rotation",
-			fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule rotation", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleRotationBetaFromAPI(result.JSON201.Rotation, data,
 		r.scheduleTimezone(ctx, data.ScheduleID.ValueString())))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleRotationBetaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -1060,6 +1068,9 @@ func (r *IncidentScheduleRotationBetaResource) Read(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.SchedulesV3ShowRotationWithResponse(ctx,
 		data.ScheduleID.ValueString(), data.ID.ValueString())
 	if isNotFound(err) {
@@ -1067,20 +1078,20 @@ func (r *IncidentScheduleRotationBetaResource) Read(ctx context.Context, req res
 		return
 	}
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to read schedule rotation", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule rotation", err)
 		return
 	}
 	// The client turns any non-2xx into an error, so a missing body is an unexpected
 	// success response rather than a deleted rotation. Dropping it from state would
 	// make the next apply create a second rotation, so fail instead.
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to read schedule rotation",
-			fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule rotation", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleRotationBetaFromAPI(result.JSON200.Rotation, data,
 		r.scheduleTimezone(ctx, data.ScheduleID.ValueString())))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleRotationBetaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -1091,6 +1102,9 @@ func (r *IncidentScheduleRotationBetaResource) Update(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, plan.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	startsAt, diags := plan.FirstIntervalStartsAt.ValueRFC3339Time()
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -1129,17 +1143,17 @@ func (r *IncidentScheduleRotationBetaResource) Update(ctx context.Context, req r
 	result, err := r.client.SchedulesV3UpdateRotationWithResponse(ctx,
 		state.ScheduleID.ValueString(), state.ID.ValueString(), body)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to update schedule rotation", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule rotation", err)
 		return
 	}
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to update schedule rotation",
-			fmt.Sprintf("unexpected response: %s", result.Status()))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule rotation", fmt.Errorf("unexpected response: %s", result.Status()))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, incidentScheduleRotationBetaFromAPI(result.JSON200.Rotation, plan,
 		r.scheduleTimezone(ctx, state.ScheduleID.ValueString())))...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleRotationBetaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -1149,21 +1163,25 @@ func (r *IncidentScheduleRotationBetaResource) Delete(ctx context.Context, req r
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.SchedulesV3DestroyRotationWithResponse(ctx,
 		data.ScheduleID.ValueString(), data.ID.ValueString())
 	if err != nil && !isNotFound(err) {
-		resp.Diagnostics.AddError("Unable to delete schedule rotation", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule rotation", err)
 	}
 }
 
 // ImportState takes "<schedule_id>:<rotation_id>", since a rotation is only
 // addressable through the schedule that holds it.
 func (r *IncidentScheduleRotationBetaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	scheduleID, rotationID, found := strings.Cut(req.ID, ":")
+	id := importID(ctx, req, ":", &resp.Diagnostics, "schedule_id", "id")
+	scheduleID, rotationID, found := strings.Cut(id, ":")
 	if !found || scheduleID == "" || rotationID == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import identifier",
-			fmt.Sprintf("expected <schedule_id>:<rotation_id>, got %q.", req.ID),
+			fmt.Sprintf("expected <schedule_id>:<rotation_id>, got %q.", id),
 		)
 		return
 	}
@@ -1172,6 +1190,13 @@ func (r *IncidentScheduleRotationBetaResource) ImportState(ctx context.Context,
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), rotationID)...)
 }
 
+func (r *IncidentScheduleRotationBetaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"schedule_id": "The ID of the schedule the rotation belongs to.",
+		"id":          "The ID of the rotation.",
+	})
+}
+
 func toUserReferences(users []types.String) []client.UserReferencePayloadV2 {
 	references := make([]client.UserReferencePayloadV2, len(users))
 	for i, user := range users {
@@ -1427,5 +1452,6 @@ func incidentScheduleRotationBetaFromAPI(rotation client.ScheduleRotationV3, con
 		// nothing to return for it.
 		Rollout:       config.Rollout,
 		EffectiveFrom: timetypes.NewRFC3339TimePointerValue(rotation.EffectiveFrom),
+		Timeouts:      config.Timeouts,
 	}
 }
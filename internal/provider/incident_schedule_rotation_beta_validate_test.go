@@ -85,6 +85,7 @@ func validateScheduleRotation(t *testing.T, overrides map[string]tftypes.Value)
 		"scheduling_mode":          tftypes.NewValue(tftypes.String, nil),
 		"rollout":                  tftypes.NewValue(tftypes.String, nil),
 		"effective_from":           tftypes.NewValue(tftypes.String, nil),
+		"timeouts":                 tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
 	}
 	for name, value := range overrides {
 		if _, ok := attributes[name]; !ok {
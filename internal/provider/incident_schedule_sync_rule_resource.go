@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                = &IncidentScheduleSyncRuleResource{}
 	_ resource.ResourceWithConfigure   = &IncidentScheduleSyncRuleResource{}
 	_ resource.ResourceWithImportState = &IncidentScheduleSyncRuleResource{}
+	_ resource.ResourceWithIdentity    = &IncidentScheduleSyncRuleResource{}
 )
 
 type IncidentScheduleSyncRuleResource struct {
@@ -81,6 +82,9 @@ func (r *IncidentScheduleSyncRuleResource) Schema(ctx context.Context, req resou
 				MarkdownDescription: apischema.Docstring("ScheduleSyncRuleV2", "permanent_member_user_ids") + "\n\nOmitting the attribute leaves existing permanent members unchanged on update. Set to `[]` to clear them.",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -109,6 +113,9 @@ func (r *IncidentScheduleSyncRuleResource) Create(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	plannedPermanentMembers := data.PermanentMemberUserIDs
 
 	result, err := r.client.SchedulesV2CreateScheduleSyncRuleWithResponse(ctx, data.ScheduleID.ValueString(), client.SchedulesCreateScheduleSyncRulePayloadV2{
@@ -123,16 +130,19 @@ func (r *IncidentScheduleSyncRuleResource) Create(ctx context.Context, req resou
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule sync rule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule sync rule", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created schedule sync rule with id=%s", result.JSON201.ScheduleSyncRule.Id))
 
-	data = models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON201.ScheduleSyncRule)
+	model := models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON201.ScheduleSyncRule)
+	model.Timeouts = data.Timeouts
+	data = model
 	data.PreserveEmptyPermanentMemberUserIDs(plannedPermanentMembers)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleSyncRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -142,6 +152,9 @@ func (r *IncidentScheduleSyncRuleResource) Read(ctx context.Context, req resourc
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	priorPermanentMembers := data.PermanentMemberUserIDs
 
 	result, err := r.client.SchedulesV2ShowScheduleSyncRuleWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString())
@@ -152,7 +165,7 @@ func (r *IncidentScheduleSyncRuleResource) Read(ctx context.Context, req resourc
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule sync rule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule sync rule", err)
 		return
 	}
 
@@ -164,7 +177,9 @@ func (r *IncidentScheduleSyncRuleResource) Read(ctx context.Context, req resourc
 		return
 	}
 
-	data = models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON200.ScheduleSyncRule)
+	model := models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON200.ScheduleSyncRule)
+	model.Timeouts = data.Timeouts
+	data = model
 	// When the attribute is unset in state, leave it null even if the API
 	// returns members set outside Terraform — omitting the field on update
 	// means "leave unchanged", so we must not invent a diff that would clear
@@ -176,6 +191,7 @@ func (r *IncidentScheduleSyncRuleResource) Read(ctx context.Context, req resourc
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleSyncRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -185,6 +201,9 @@ func (r *IncidentScheduleSyncRuleResource) Update(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	plannedPermanentMembers := data.PermanentMemberUserIDs
 
 	result, err := r.client.SchedulesV2UpdateScheduleSyncRuleWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString(), client.SchedulesUpdateScheduleSyncRulePayloadV2{
@@ -195,11 +214,13 @@ func (r *IncidentScheduleSyncRuleResource) Update(ctx context.Context, req resou
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule sync rule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule sync rule", err)
 		return
 	}
 
-	data = models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON200.ScheduleSyncRule)
+	model := models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON200.ScheduleSyncRule)
+	model.Timeouts = data.Timeouts
+	data = model
 	if plannedPermanentMembers.IsNull() {
 		// Omitted on the wire — members are unchanged and still unmanaged.
 		data.PermanentMemberUserIDs = types.SetNull(types.StringType)
@@ -208,6 +229,7 @@ func (r *IncidentScheduleSyncRuleResource) Update(ctx context.Context, req resou
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "schedule_id", "id")
 }
 
 func (r *IncidentScheduleSyncRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -217,9 +239,12 @@ func (r *IncidentScheduleSyncRuleResource) Delete(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.SchedulesV2DestroyScheduleSyncRuleWithResponse(ctx, data.ScheduleID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schedule sync rule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule sync rule", err)
 		return
 	}
 }
@@ -237,11 +262,12 @@ func parseScheduleSyncRuleImportID(importID string) (scheduleID string, ruleID s
 }
 
 func (r *IncidentScheduleSyncRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	scheduleID, ruleID, ok := parseScheduleSyncRuleImportID(req.ID)
+	id := importID(ctx, req, ":", &resp.Diagnostics, "schedule_id", "id")
+	scheduleID, ruleID, ok := parseScheduleSyncRuleImportID(id)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("The import ID must be in the format: schedule_id:rule_id (got %q)", req.ID),
+			fmt.Sprintf("The import ID must be in the format: schedule_id:rule_id (got %q)", id),
 		)
 		return
 	}
@@ -261,7 +287,7 @@ func (r *IncidentScheduleSyncRuleResource) ImportState(ctx context.Context, req
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule sync rule, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule sync rule", err)
 		return
 	}
 
@@ -279,6 +305,14 @@ func (r *IncidentScheduleSyncRuleResource) ImportState(ctx context.Context, req
 	}
 
 	data := models.ScheduleSyncRuleResourceModel{}.FromAPI(result.JSON200.ScheduleSyncRule)
+	data.Timeouts = nullTimeouts(ctx)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (r *IncidentScheduleSyncRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"schedule_id": "The ID of the schedule the sync rule belongs to.",
+		"id":          "The ID of the sync rule.",
+	})
+}
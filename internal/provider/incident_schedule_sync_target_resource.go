@@ -22,6 +22,7 @@ var (
 	_ resource.Resource                   = &IncidentScheduleSyncTargetResource{}
 	_ resource.ResourceWithConfigure      = &IncidentScheduleSyncTargetResource{}
 	_ resource.ResourceWithImportState    = &IncidentScheduleSyncTargetResource{}
+	_ resource.ResourceWithIdentity       = &IncidentScheduleSyncTargetResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentScheduleSyncTargetResource{}
 )
 
@@ -104,6 +105,9 @@ func (r *IncidentScheduleSyncTargetResource) Schema(ctx context.Context, req res
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -161,6 +165,9 @@ func (r *IncidentScheduleSyncTargetResource) Create(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	payload := data.ToPayload()
 	payload.Annotations = &map[string]string{
 		"incident.io/terraform/version": r.terraformVersion,
@@ -169,7 +176,7 @@ func (r *IncidentScheduleSyncTargetResource) Create(ctx context.Context, req res
 		ScheduleSyncTarget: payload,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create schedule sync target, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create schedule sync target", err)
 		return
 	}
 
@@ -178,10 +185,13 @@ func (r *IncidentScheduleSyncTargetResource) Create(ctx context.Context, req res
 	// Preserve new_slack_user_group from plan since it's not returned by API
 	newSlackUserGroup := data.NewSlackUserGroup
 
-	data = models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON201.ScheduleSyncTarget)
+	model := models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON201.ScheduleSyncTarget)
+	model.Timeouts = data.Timeouts
+	data = model
 	data.NewSlackUserGroup = newSlackUserGroup
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleSyncTargetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -191,6 +201,9 @@ func (r *IncidentScheduleSyncTargetResource) Read(ctx context.Context, req resou
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.ScheduleSyncTargetsV2ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		httpErr := client.HTTPError{}
@@ -199,7 +212,7 @@ func (r *IncidentScheduleSyncTargetResource) Read(ctx context.Context, req resou
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule sync target, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule sync target", err)
 		return
 	}
 
@@ -214,10 +227,13 @@ func (r *IncidentScheduleSyncTargetResource) Read(ctx context.Context, req resou
 	// Preserve new_slack_user_group from state since it's not returned by API
 	newSlackUserGroup := data.NewSlackUserGroup
 
-	data = models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON200.ScheduleSyncTarget)
+	model := models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON200.ScheduleSyncTarget)
+	model.Timeouts = data.Timeouts
+	data = model
 	data.NewSlackUserGroup = newSlackUserGroup
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleSyncTargetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -227,6 +243,9 @@ func (r *IncidentScheduleSyncTargetResource) Update(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.ScheduleSyncTargetsV2UpdateWithResponse(ctx, data.ID.ValueString(), client.ScheduleSyncTargetsUpdatePayloadV2{
 		AddBotToGroup: data.AddBotToGroup.ValueBool(),
 		Annotations: &map[string]string{
@@ -234,17 +253,20 @@ func (r *IncidentScheduleSyncTargetResource) Update(ctx context.Context, req res
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update schedule sync target, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update schedule sync target", err)
 		return
 	}
 
 	// Preserve new_slack_user_group from plan since it's not returned by API
 	newSlackUserGroup := data.NewSlackUserGroup
 
-	data = models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON200.ScheduleSyncTarget)
+	model := models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON200.ScheduleSyncTarget)
+	model.Timeouts = data.Timeouts
+	data = model
 	data.NewSlackUserGroup = newSlackUserGroup
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentScheduleSyncTargetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -254,15 +276,18 @@ func (r *IncidentScheduleSyncTargetResource) Delete(ctx context.Context, req res
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.ScheduleSyncTargetsV2DestroyWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete schedule sync target, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete schedule sync target", err)
 		return
 	}
 }
 
 func (r *IncidentScheduleSyncTargetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	targetID := strings.TrimSpace(req.ID)
+	targetID := strings.TrimSpace(importID(ctx, req, "", &resp.Diagnostics, "id"))
 	if targetID == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
@@ -286,7 +311,7 @@ func (r *IncidentScheduleSyncTargetResource) ImportState(ctx context.Context, re
 			)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read schedule sync target, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read schedule sync target", err)
 		return
 	}
 
@@ -307,6 +332,11 @@ func (r *IncidentScheduleSyncTargetResource) ImportState(ctx context.Context, re
 	// never part of an imported target: the group already exists, and the
 	// imported configuration should reference it with slack_user_group_id.
 	data := models.ScheduleSyncTargetResourceModel{}.FromAPI(result.JSON200.ScheduleSyncTarget)
+	data.Timeouts = nullTimeouts(ctx)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+func (r *IncidentScheduleSyncTargetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                = &IncidentSecretResource{}
+	_ resource.ResourceWithConfigure   = &IncidentSecretResource{}
+	_ resource.ResourceWithImportState = &IncidentSecretResource{}
+	_ resource.ResourceWithIdentity    = &IncidentSecretResource{}
+	_ resource.ResourceWithModifyPlan  = &IncidentSecretResource{}
+)
+
+type IncidentSecretResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentSecretResourceModel struct {
+	ID              types.String   `tfsdk:"id"`
+	Name            types.String   `tfsdk:"name"`
+	Description     types.String   `tfsdk:"description"`
+	OwningTeamIDs   types.Set      `tfsdk:"owning_team_ids"`
+	ValueWO         types.String   `tfsdk:"value_wo"`
+	RotationTrigger types.String   `tfsdk:"rotation_trigger"`
+	Version         types.Int64    `tfsdk:"version"`
+	LastFourChars   types.String   `tfsdk:"last_four_chars"`
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentSecretResource() resource.Resource {
+	return &IncidentSecretResource{}
+}
+
+func (r *IncidentSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secret"
+}
+
+func (r *IncidentSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage a secret: a named credential that workflows and alert sources can reference,
+such as the auth token for an outgoing webhook.
+
+The value is write-only. It's sent to incident.io when the secret is created, and
+never stored in Terraform state or read back from the API, so Terraform can't tell
+when it changes. To change the value, update ` + "`value_wo`" + ` and change
+` + "`rotation_trigger`" + ` in the same apply: the provider then rotates the secret,
+which adds a new version and retires the previous one.
+
+Write-only attributes need Terraform 1.11 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("SecretV2", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SecretV2", "name"),
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SecretV2", "description"),
+				Optional:            true,
+			},
+			"owning_team_ids": schema.SetAttribute{
+				MarkdownDescription: apischema.Docstring("SecretV2", "owning_team_ids"),
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"value_wo": schema.StringAttribute{
+				MarkdownDescription: "The secret's plaintext value. This is write-only: it's never stored in state, and changing it alone doesn't update the secret. Change `rotation_trigger` alongside it to rotate the secret to the new value. It's needed to create or rotate the secret, and may be null otherwise, such as when it comes from an `incident_api_key_token` that only has a token in the apply that issues it.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"rotation_trigger": schema.StringAttribute{
+				MarkdownDescription: "Any value, such as a version number or a date. Changing it rotates the secret to the current `value_wo`.",
+				Optional:            true,
+			},
+			"version": schema.Int64Attribute{
+				MarkdownDescription: apischema.Docstring("SecretV2", "version"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_four_chars": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("SecretV2", "last_four_chars"),
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+// ModifyPlan marks the version and masked value as changing when a rotation is planned.
+// UseStateForUnknown would otherwise carry the old ones forward, and the apply would fail
+// when the rotation returns a new version. Creating or rotating a secret needs a value.
+func (r *IncidentSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var value types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if req.State.Raw.IsNull() {
+		requireSecretValue(value, "create", &resp.Diagnostics)
+		return
+	}
+
+	var state, plan *IncidentSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !secretRotationPlanned(state, plan) {
+		return
+	}
+
+	requireSecretValue(value, "rotate", &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Version = types.Int64Unknown()
+	plan.LastFourChars = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// requireSecretValue fails the plan when there's no value to create or rotate the secret
+// with. A value that's unknown until apply is fine.
+func requireSecretValue(value types.String, doing string, diags *diag.Diagnostics) {
+	if !value.IsNull() {
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root("value_wo"),
+		"Missing secret value",
+		fmt.Sprintf("value_wo must be set to %s a secret.", doing),
+	)
+}
+
+// secretRotationPlanned is true when rotation_trigger changes, including when it's unknown
+// until apply.
+func secretRotationPlanned(state, plan *IncidentSecretResourceModel) bool {
+	if plan.RotationTrigger.IsUnknown() {
+		return true
+	}
+
+	return !plan.RotationTrigger.Equal(state.RotationTrigger)
+}
+
+func (r *IncidentSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// Write-only values are only ever in the config: the plan always holds null.
+	var value types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &value)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requestBody := client.SecretsV2CreateJSONRequestBody{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Value:       value.ValueString(),
+	}
+	if !data.OwningTeamIDs.IsUnknown() && !data.OwningTeamIDs.IsNull() {
+		ids := []string{}
+		resp.Diagnostics.Append(data.OwningTeamIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestBody.OwningTeamIds = &ids
+	}
+
+	result, err := r.client.SecretsV2CreateWithResponse(ctx, requestBody)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to create secret '%s'", data.Name.ValueString()), err)
+		return
+	}
+
+	// Unlike workflows and alert sources, secrets aren't a managed resource type in the
+	// API, so there's nothing to claim: the dashboard won't mark them as managed by
+	// Terraform.
+	tflog.Trace(ctx, fmt.Sprintf("created a secret resource with id=%s", result.JSON201.Secret.Id))
+	data = r.buildModel(result.JSON201.Secret, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.SecretsV2ShowWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		httpErr := client.HTTPError{}
+		if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+			tflog.Warn(ctx, fmt.Sprintf("Secret with ID %s not found: removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read secret", err)
+		return
+	}
+
+	data = r.buildModel(result.JSON200.Secret, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state *IncidentSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	requestBody := client.SecretsV2UpdateJSONRequestBody{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	if !data.OwningTeamIDs.IsUnknown() && !data.OwningTeamIDs.IsNull() {
+		ids := []string{}
+		resp.Diagnostics.Append(data.OwningTeamIDs.ElementsAs(ctx, &ids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		requestBody.OwningTeamIds = &ids
+	}
+
+	result, err := r.client.SecretsV2UpdateWithResponse(ctx, data.ID.ValueString(), requestBody)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update secret", err)
+		return
+	}
+	secret := result.JSON200.Secret
+
+	if secretRotationPlanned(state, data) {
+		var value types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value_wo"), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		rotated, err := r.client.SecretsV2RotateWithResponse(ctx, data.ID.ValueString(), client.SecretsV2RotateJSONRequestBody{
+			Value: value.ValueString(),
+		})
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to rotate secret", err)
+			return
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("rotated secret with id=%s to version %d", rotated.JSON200.Secret.Id, rotated.JSON200.Secret.Version))
+		secret = rotated.JSON200.Secret
+	}
+
+	data = r.buildModel(secret, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.client.SecretsV2DestroyWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete secret", err)
+		return
+	}
+}
+
+// ImportState takes the secret's ID. The value can't be read back, so an imported secret
+// keeps whatever value it has until rotation_trigger is next changed.
+func (r *IncidentSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentSecretResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// buildModel converts from the response type to the terraform model/schema type. prior
+// is the plan (create/update) or prior state (read), and carries the attributes the API
+// doesn't return.
+func (r *IncidentSecretResource) buildModel(secret client.SecretV2, prior *IncidentSecretResourceModel) *IncidentSecretResourceModel {
+	model := &IncidentSecretResourceModel{
+		ID:              types.StringValue(secret.Id),
+		Name:            types.StringValue(secret.Name),
+		Description:     types.StringPointerValue(secret.Description),
+		ValueWO:         types.StringNull(),
+		RotationTrigger: types.StringNull(),
+		Version:         types.Int64Value(secret.Version),
+		LastFourChars:   types.StringPointerValue(secret.LastFourChars),
+	}
+
+	// As with catalog types, only reflect owners back into state when the config manages
+	// them, so leaving the attribute out doesn't produce a perpetual diff.
+	if prior != nil && !prior.OwningTeamIDs.IsUnknown() && !prior.OwningTeamIDs.IsNull() {
+		model.OwningTeamIDs = owningTeamIDsToSet(&secret.OwningTeamIds)
+	} else {
+		model.OwningTeamIDs = types.SetNull(types.StringType)
+	}
+
+	if prior != nil {
+		model.RotationTrigger = prior.RotationTrigger
+		model.Timeouts = prior.Timeouts
+	}
+
+	return model
+}
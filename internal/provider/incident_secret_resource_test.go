@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIncidentSecretResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccIncidentSecretResourceConfig("Webhook token", "sk_test_abc123", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "name", StableSuffix("Webhook token")),
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "last_four_chars", "c123"),
+					resource.TestCheckNoResourceAttr(
+						"incident_secret.example", "value_wo"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_secret.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// rotation_trigger only exists in config.
+				ImportStateVerifyIgnore: []string{"rotation_trigger"},
+			},
+			// Rename without rotating: a new value_wo alone is ignored
+			{
+				Config: testAccIncidentSecretResourceConfig("Webhook token renamed", "sk_test_def456", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "name", StableSuffix("Webhook token renamed")),
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "version", "1"),
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "last_four_chars", "c123"),
+				),
+			},
+			// Rotate
+			{
+				Config: testAccIncidentSecretResourceConfig("Webhook token renamed", "sk_test_def456", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "version", "2"),
+					resource.TestCheckResourceAttr(
+						"incident_secret.example", "last_four_chars", "f456"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentSecretResourceConfig(name, value, rotationTrigger string) string {
+	return testRunTemplate("incident_secret", `
+resource "incident_secret" "example" {
+  name             = {{ stableSuffix .Name | quote }}
+  description      = "Auth token for the test webhook"
+  value_wo         = {{ quote .Value }}
+  rotation_trigger = {{ quote .RotationTrigger }}
+}
+`, struct {
+		Name            string
+		Value           string
+		RotationTrigger string
+	}{
+		Name:            name,
+		Value:           value,
+		RotationTrigger: rotationTrigger,
+	})
+}
+
+func TestSecretRotationPlanned(t *testing.T) {
+	cases := []struct {
+		name  string
+		state types.String
+		plan  types.String
+		want  bool
+	}{
+		{
+			name:  "unchanged",
+			state: types.StringValue("1"),
+			plan:  types.StringValue("1"),
+			want:  false,
+		},
+		{
+			name:  "both null",
+			state: types.StringNull(),
+			plan:  types.StringNull(),
+			want:  false,
+		},
+		{
+			name:  "changed",
+			state: types.StringValue("1"),
+			plan:  types.StringValue("2"),
+			want:  true,
+		},
+		{
+			name:  "set for the first time",
+			state: types.StringNull(),
+			plan:  types.StringValue("1"),
+			want:  true,
+		},
+		{
+			name:  "removed",
+			state: types.StringValue("1"),
+			plan:  types.StringNull(),
+			want:  true,
+		},
+		{
+			name:  "unknown until apply",
+			state: types.StringValue("1"),
+			plan:  types.StringUnknown(),
+			want:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := secretRotationPlanned(
+				&IncidentSecretResourceModel{RotationTrigger: tc.state},
+				&IncidentSecretResourceModel{RotationTrigger: tc.plan},
+			)
+			if got != tc.want {
+				t.Errorf("secretRotationPlanned = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRequireSecretValue(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   types.String
+		wantErr bool
+	}{
+		{name: "set", value: types.StringValue("sk_test_abc123"), wantErr: false},
+		{name: "unknown until apply", value: types.StringUnknown(), wantErr: false},
+		{name: "null", value: types.StringNull(), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			requireSecretValue(tc.value, "rotate", &diags)
+			if diags.HasError() != tc.wantErr {
+				t.Errorf("requireSecretValue errored = %v, want %v: %+v", diags.HasError(), tc.wantErr, diags)
+			}
+		})
+	}
+}
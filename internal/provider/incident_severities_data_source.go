@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentSeveritiesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentSeveritiesDataSource{}
+)
+
+func NewIncidentSeveritiesDataSource() datasource.DataSource {
+	return &IncidentSeveritiesDataSource{}
+}
+
+type IncidentSeveritiesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentSeveritiesDataSourceModel struct {
+	All map[string]IncidentSeverityDataSourceModel `tfsdk:"all"`
+}
+
+func (d *IncidentSeveritiesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentSeveritiesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_severities"
+}
+
+func (d *IncidentSeveritiesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentSeveritiesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.SeveritiesV1ListWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list severities", err)
+		return
+	}
+
+	// Severity names are unique, so they make a stable key to look severities up by.
+	data.All = map[string]IncidentSeverityDataSourceModel{}
+	for _, severity := range result.JSON200.Severities {
+		data.All[severity.Name] = buildSeverityDataSourceModel(severity)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IncidentSeveritiesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides every severity in your organisation, keyed by name.",
+		Attributes: map[string]schema.Attribute{
+			"all": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every severity, keyed by its name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("SeverityV1", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("SeverityV1", "name"),
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("SeverityV1", "description"),
+						},
+						"rank": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("SeverityV1", "rank"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
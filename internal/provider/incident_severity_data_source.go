@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentSeverityDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentSeverityDataSource{}
+)
+
+func NewIncidentSeverityDataSource() datasource.DataSource {
+	return &IncidentSeverityDataSource{}
+}
+
+type IncidentSeverityDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentSeverityDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Rank        types.Int64  `tfsdk:"rank"`
+}
+
+func (d *IncidentSeverityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentSeverityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_severity"
+}
+
+func (d *IncidentSeverityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentSeverityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.SeveritiesV1ListWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list severities", err)
+		return
+	}
+
+	severity, err := selectSeverity(result.JSON200.Severities, data.ID, data.Name, data.Rank)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read severity", err)
+		return
+	}
+
+	modelResp := buildSeverityDataSourceModel(*severity)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// selectSeverity finds the one severity matching every lookup attribute that's set.
+func selectSeverity(severities []client.SeverityV1, id, name types.String, rank types.Int64) (*client.SeverityV1, error) {
+	if id.IsNull() && name.IsNull() && rank.IsNull() {
+		return nil, errors.New("no id, name or rank provided")
+	}
+
+	matches := lo.Filter(severities, func(severity client.SeverityV1, _ int) bool {
+		return (id.IsNull() || severity.Id == id.ValueString()) &&
+			(name.IsNull() || severity.Name == name.ValueString()) &&
+			(rank.IsNull() || severity.Rank == rank.ValueInt64())
+	})
+	if len(matches) == 0 {
+		return nil, errors.New("severity not found")
+	} else if len(matches) > 1 {
+		return nil, errors.New("multiple severities found")
+	}
+
+	return &matches[0], nil
+}
+
+func buildSeverityDataSourceModel(severity client.SeverityV1) IncidentSeverityDataSourceModel {
+	return IncidentSeverityDataSourceModel{
+		ID:          types.StringValue(severity.Id),
+		Name:        types.StringValue(severity.Name),
+		Description: types.StringValue(severity.Description),
+		Rank:        types.Int64Value(severity.Rank),
+	}
+}
+
+func (d *IncidentSeverityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides information about a severity. Look it up by any combination of `id`, `name` and `rank`: exactly one severity must match.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("SeverityV1", "id"),
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("SeverityV1", "name"),
+			},
+			"rank": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("SeverityV1", "rank"),
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("SeverityV1", "description"),
+			},
+		},
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentSeverityDataSource(t *testing.T) {
+	name := StableSuffix("Data source severity")
+	// Clear of the ranks the severity resource tests use.
+	rank := stableRank() + 1000
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentSeverityDataSourceConfig(name, rank),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_severity.by_name", "id",
+						"incident_severity.example", "id"),
+					resource.TestCheckResourceAttr("data.incident_severity.by_name", "rank", fmt.Sprintf("%d", rank)),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_severity.by_rank", "id",
+						"incident_severity.example", "id"),
+					resource.TestCheckResourceAttr("data.incident_severity.by_rank", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_severities.main", fmt.Sprintf("all.%s.id", name),
+						"incident_severity.example", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentSeverityDataSourceConfig(name string, rank int64) string {
+	return testRunTemplate("incident_severity_data_source", `
+resource "incident_severity" "example" {
+  name        = {{ quote .Name }}
+  description = "Used to test the severity data sources."
+  rank        = {{ .Rank }}
+}
+
+data "incident_severity" "by_name" {
+  name = incident_severity.example.name
+}
+
+data "incident_severity" "by_rank" {
+  rank = incident_severity.example.rank
+}
+
+data "incident_severities" "main" {
+  depends_on = [incident_severity.example]
+}
+`, struct {
+		Name string
+		Rank int64
+	}{
+		Name: name,
+		Rank: rank,
+	})
+}
+
+func TestSelectSeverity(t *testing.T) {
+	severities := []client.SeverityV1{
+		{Id: "sev-minor", Name: "Minor", Rank: 1},
+		{Id: "sev-major", Name: "Major", Rank: 2},
+		{Id: "sev-critical", Name: "Critical", Rank: 3},
+	}
+
+	// Unset lookups are left as zero values, which are null.
+	testCases := []struct {
+		name        string
+		id          types.String
+		lookupName  types.String
+		rank        types.Int64
+		expectedID  string
+		expectedErr string
+	}{
+		{name: "by id", id: types.StringValue("sev-major"), expectedID: "sev-major"},
+		{name: "by name", lookupName: types.StringValue("Critical"), expectedID: "sev-critical"},
+		{name: "by rank", rank: types.Int64Value(1), expectedID: "sev-minor"},
+		{name: "by name and rank", lookupName: types.StringValue("Major"), rank: types.Int64Value(2), expectedID: "sev-major"},
+		{name: "name and rank disagree", lookupName: types.StringValue("Major"), rank: types.Int64Value(3), expectedErr: "severity not found"},
+		{name: "unknown name", lookupName: types.StringValue("Catastrophic"), expectedErr: "severity not found"},
+		{name: "nothing to look up by", expectedErr: "no id, name or rank provided"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			severity, err := selectSeverity(severities, tc.id, tc.lookupName, tc.rank)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedID, severity.Id)
+		})
+	}
+}
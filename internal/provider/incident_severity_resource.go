@@ -5,7 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -21,6 +22,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentSeverityResource{}
 	_ resource.ResourceWithImportState = &IncidentSeverityResource{}
+	_ resource.ResourceWithIdentity    = &IncidentSeverityResource{}
 )
 
 type IncidentSeverityResource struct {
@@ -28,16 +30,21 @@ type IncidentSeverityResource struct {
 }
 
 type IncidentSeverityResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Rank        types.Int64  `tfsdk:"rank"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Rank        types.Int64    `tfsdk:"rank"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentSeverityResource() resource.Resource {
 	return &IncidentSeverityResource{}
 }
 
+func NewIncidentSeverityListResource() list.ListResource {
+	return newIDListResource(NewIncidentSeverityResource, listSeverityResources)
+}
+
 func (r *IncidentSeverityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_severity"
 }
@@ -67,6 +74,9 @@ func (r *IncidentSeverityResource) Schema(ctx context.Context, req resource.Sche
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -94,6 +104,10 @@ func (r *IncidentSeverityResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	var rank *int64
 	if !data.Rank.IsUnknown() {
 		rank = lo.ToPtr(data.Rank.ValueInt64())
@@ -104,13 +118,16 @@ func (r *IncidentSeverityResource) Create(ctx context.Context, req resource.Crea
 		Rank:        rank,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident severity '%s', got error: %s", data.Name.ValueString(), err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to create incident severity '%s'", data.Name.ValueString()), err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident severity resource with id=%s", result.JSON201.Severity.Id))
-	data = r.buildModel(result.JSON201.Severity)
+	model := r.buildModel(result.JSON201.Severity)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentSeverityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -120,6 +137,9 @@ func (r *IncidentSeverityResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.SeveritiesV1ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -129,12 +149,15 @@ func (r *IncidentSeverityResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident severity, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read incident severity", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.Severity)
+	model := r.buildModel(result.JSON200.Severity)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentSeverityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -144,6 +167,9 @@ func (r *IncidentSeverityResource) Update(ctx context.Context, req resource.Upda
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	var rank *int64
 	if !data.Rank.IsNull() {
 		rank = lo.ToPtr(data.Rank.ValueInt64())
@@ -154,12 +180,15 @@ func (r *IncidentSeverityResource) Update(ctx context.Context, req resource.Upda
 		Rank:        rank,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident severity, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update incident severity", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.Severity)
+	model := r.buildModel(result.JSON200.Severity)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentSeverityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -169,15 +198,22 @@ func (r *IncidentSeverityResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.SeveritiesV1DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident severity, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete incident severity", err)
 		return
 	}
 }
 
 func (r *IncidentSeverityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentSeverityResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentSeverityResource) buildModel(severity client.SeverityV1) *IncidentSeverityResourceModel {
@@ -188,3 +224,21 @@ func (r *IncidentSeverityResource) buildModel(severity client.SeverityV1) *Incid
 		Rank:        types.Int64Value(severity.Rank),
 	}
 }
+
+// listSeverityResources lists all severities for `terraform query`.
+func listSeverityResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.SeveritiesV1ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing severities: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.Severities {
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
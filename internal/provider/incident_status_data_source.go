@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentStatusDataSource{}
+)
+
+func NewIncidentStatusDataSource() datasource.DataSource {
+	return &IncidentStatusDataSource{}
+}
+
+type IncidentStatusDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Category    types.String `tfsdk:"category"`
+	Description types.String `tfsdk:"description"`
+	Rank        types.Int64  `tfsdk:"rank"`
+}
+
+func (d *IncidentStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status"
+}
+
+func (d *IncidentStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.IncidentStatusesV1ListWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list incident statuses", err)
+		return
+	}
+
+	status, err := selectIncidentStatus(result.JSON200.IncidentStatuses, data.ID, data.Name, data.Category)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read incident status", err)
+		return
+	}
+
+	modelResp := buildIncidentStatusDataSourceModel(*status)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// selectIncidentStatus finds the one status matching every lookup attribute that's set.
+// Most categories hold a single status, so a category alone is usually enough, but live
+// and learning can hold several.
+func selectIncidentStatus(statuses []client.IncidentStatusV1, id, name, category types.String) (*client.IncidentStatusV1, error) {
+	if id.IsNull() && name.IsNull() && category.IsNull() {
+		return nil, errors.New("no id, name or category provided")
+	}
+
+	matches := lo.Filter(statuses, func(status client.IncidentStatusV1, _ int) bool {
+		return (id.IsNull() || status.Id == id.ValueString()) &&
+			(name.IsNull() || status.Name == name.ValueString()) &&
+			(category.IsNull() || string(status.Category) == category.ValueString())
+	})
+	if len(matches) == 0 {
+		return nil, errors.New("incident status not found")
+	} else if len(matches) > 1 {
+		names := lo.Map(matches, func(status client.IncidentStatusV1, _ int) string { return status.Name })
+		return nil, fmt.Errorf("multiple incident statuses found (%s): set name to choose one", strings.Join(names, ", "))
+	}
+
+	return &matches[0], nil
+}
+
+func buildIncidentStatusDataSourceModel(status client.IncidentStatusV1) IncidentStatusDataSourceModel {
+	return IncidentStatusDataSourceModel{
+		ID:          types.StringValue(status.Id),
+		Name:        types.StringValue(status.Name),
+		Category:    types.StringValue(string(status.Category)),
+		Description: types.StringValue(status.Description),
+		Rank:        types.Int64Value(status.Rank),
+	}
+}
+
+func (d *IncidentStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides information about an incident status. Look it up by any combination of `id`, `name` and `category`: exactly one status must match.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IncidentStatusV1", "id"),
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IncidentStatusV1", "name"),
+			},
+			"category": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: EnumValuesDescription("IncidentStatusV1", "category"),
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IncidentStatusV1", "description"),
+			},
+			"rank": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("IncidentStatusV1", "rank"),
+			},
+		},
+	}
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentStatusDataSource(t *testing.T) {
+	name := StableSuffix("Data source status")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentStatusDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.incident_status.by_name", "id",
+						"incident_status.example", "id"),
+					resource.TestCheckResourceAttr("data.incident_status.by_name", "category", "live"),
+					resource.TestCheckResourceAttrSet("data.incident_status.by_name", "rank"),
+
+					// Every organisation has exactly one triage status
+					resource.TestCheckResourceAttr("data.incident_status.triage", "category", "triage"),
+					resource.TestCheckResourceAttrSet("data.incident_status.triage", "id"),
+
+					resource.TestCheckResourceAttrPair(
+						"data.incident_statuses.live", "all."+name+".id",
+						"incident_status.example", "id"),
+					resource.TestCheckNoResourceAttr(
+						"data.incident_statuses.live", "all.Triage.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIncidentStatusDataSourceConfig(name string) string {
+	return testRunTemplate("incident_status_data_source", `
+resource "incident_status" "example" {
+  name        = {{ quote .Name }}
+  description = "Used to test the status data sources."
+  category    = "live"
+}
+
+data "incident_status" "by_name" {
+  name = incident_status.example.name
+}
+
+data "incident_status" "triage" {
+  category = "triage"
+}
+
+data "incident_statuses" "live" {
+  category = "live"
+
+  depends_on = [incident_status.example]
+}
+`, struct {
+		Name string
+	}{
+		Name: name,
+	})
+}
+
+func TestSelectIncidentStatus(t *testing.T) {
+	statuses := []client.IncidentStatusV1{
+		{Id: "status-triage", Name: "Triage", Category: client.IncidentStatusV1CategoryTriage},
+		{Id: "status-investigating", Name: "Investigating", Category: client.IncidentStatusV1CategoryLive},
+		{Id: "status-fixing", Name: "Fixing", Category: client.IncidentStatusV1CategoryLive},
+		{Id: "status-closed", Name: "Closed", Category: client.IncidentStatusV1CategoryClosed},
+	}
+
+	// Unset lookups are left as zero values, which are null.
+	testCases := []struct {
+		name        string
+		id          types.String
+		lookupName  types.String
+		category    types.String
+		expectedID  string
+		expectedErr string
+	}{
+		{name: "by id", id: types.StringValue("status-closed"), expectedID: "status-closed"},
+		{name: "by name", lookupName: types.StringValue("Fixing"), expectedID: "status-fixing"},
+		{name: "by category with one status", category: types.StringValue("triage"), expectedID: "status-triage"},
+		{name: "by category and name", category: types.StringValue("live"), lookupName: types.StringValue("Investigating"), expectedID: "status-investigating"},
+		{name: "by category with several statuses", category: types.StringValue("live"), expectedErr: "multiple incident statuses found (Investigating, Fixing): set name to choose one"},
+		{name: "unknown category", category: types.StringValue("paused"), expectedErr: "incident status not found"},
+		{name: "nothing to look up by", expectedErr: "no id, name or category provided"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			status, err := selectIncidentStatus(statuses, tc.id, tc.lookupName, tc.category)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedID, status.Id)
+		})
+	}
+}
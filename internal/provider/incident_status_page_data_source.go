@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentStatusPageDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentStatusPageDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentStatusPageDataSource{}
+)
+
+// statusPageLookupPageSize is the page size used when listing status pages. It's the
+// maximum the endpoint allows, so most organisations resolve in a single request.
+const statusPageLookupPageSize = 250
+
+func NewIncidentStatusPageDataSource() datasource.DataSource {
+	return &IncidentStatusPageDataSource{}
+}
+
+type IncidentStatusPageDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusPageDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	PublicURL   types.String `tfsdk:"public_url"`
+}
+
+func (d *IncidentStatusPageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (d *IncidentStatusPageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a status page by `id` or `name`. Exactly one lookup field should be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Look up the status page by ID.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Look up the status page by name. This fails if no status page, or more than one status page, has the name.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("StatusPageV2", "description"),
+			},
+			"public_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("StatusPageV2", "public_url"),
+			},
+		},
+	}
+}
+
+func (d *IncidentStatusPageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+// ValidateConfig rejects an ambiguous lookup at plan time. Both attributes are
+// Optional and Computed so either can be used, which means setting both would
+// otherwise silently ignore one of them.
+func (d *IncidentStatusPageDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *IncidentStatusPageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !data.ID.IsNull() && !data.Name.IsNull():
+		resp.Diagnostics.AddError("Ambiguous lookup", "Set either id or name, not both.")
+	case data.ID.IsNull() && data.Name.IsNull():
+		resp.Diagnostics.AddError("Missing lookup", "Set one of id or name.")
+	}
+}
+
+func (d *IncidentStatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusPageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsNull() && data.Name.IsNull() {
+		resp.Diagnostics.AddError("Missing lookup", "Set one of id or name.")
+		return
+	}
+
+	// There's no endpoint to show a single status page, so both lookups search the list.
+	statusPages, err := listStatusPages(ctx, d.client)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list status pages", err)
+		return
+	}
+
+	statusPage, err := selectStatusPage(statusPages, data.ID, data.Name)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read status page", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &IncidentStatusPageDataSourceModel{
+		ID:          types.StringValue(statusPage.Id),
+		Name:        types.StringValue(statusPage.Name),
+		Description: types.StringPointerValue(statusPage.Description),
+		PublicURL:   types.StringPointerValue(statusPage.PublicUrl),
+	})...)
+}
+
+// selectStatusPage finds the one status page with the given ID, or else the given name.
+func selectStatusPage(statusPages []client.StatusPageV2, id, name types.String) (*client.StatusPageV2, error) {
+	if !id.IsNull() {
+		statusPage, found := lo.Find(statusPages, func(statusPage client.StatusPageV2) bool {
+			return statusPage.Id == id.ValueString()
+		})
+		if !found {
+			return nil, fmt.Errorf("no status page found with id %q", id.ValueString())
+		}
+
+		return &statusPage, nil
+	}
+
+	matches := lo.Filter(statusPages, func(statusPage client.StatusPageV2, _ int) bool {
+		return statusPage.Name == name.ValueString()
+	})
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no status page found with name %q", name.ValueString())
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d status pages named %q; look it up by id instead", len(matches), name.ValueString())
+	}
+}
+
+// listStatusPages pages through every status page. The endpoint only returns a cursor
+// while pages are full, so this terminates on the last page.
+func listStatusPages(ctx context.Context, apiClient *client.ClientWithResponses) ([]client.StatusPageV2, error) {
+	var (
+		after       *string
+		statusPages []client.StatusPageV2
+	)
+
+	for {
+		result, err := apiClient.StatusPagesV2ListStatusPagesWithResponse(ctx, &client.StatusPagesV2ListStatusPagesParams{
+			PageSize: lo.ToPtr(int64(statusPageLookupPageSize)),
+			After:    after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response listing status pages: %s", result.Status())
+		}
+
+		statusPages = append(statusPages, result.JSON200.StatusPages...)
+
+		after = result.JSON200.PaginationMeta.After
+		if after == nil {
+			break
+		}
+	}
+
+	return statusPages, nil
+}
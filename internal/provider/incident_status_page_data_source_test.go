@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentStatusPageDataSourceNotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_status_page" "missing" {
+  name = "No status page has this name"
+}
+`,
+				ExpectError: regexp.MustCompile("no status page found with name"),
+			},
+		},
+	})
+}
+
+// TestAccIncidentStatusPageDataSource reads a status page and its structure.
+//
+// NOTE: The provider can't create status pages, so this needs one that already exists.
+// Set TF_ACC_STATUS_PAGE_NAME to the name of a status page with components to run it.
+func TestAccIncidentStatusPageDataSource(t *testing.T) {
+	name := os.Getenv("TF_ACC_STATUS_PAGE_NAME")
+	if name == "" {
+		t.Skip("TF_ACC_STATUS_PAGE_NAME is not set: skipping test that requires an existing status page")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+data "incident_status_page" "main" {
+  name = %q
+}
+
+data "incident_status_page_structure" "main" {
+  status_page_id = data.incident_status_page.main.id
+}
+`, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.incident_status_page.main", "id"),
+					resource.TestCheckResourceAttr("data.incident_status_page.main", "name", name),
+					resource.TestCheckResourceAttrPair(
+						"data.incident_status_page_structure.main", "status_page_id",
+						"data.incident_status_page.main", "id"),
+					testAccCheckStatusPageHasComponents("data.incident_status_page_structure.main"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckStatusPageHasComponents(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		if count := rs.Primary.Attributes["components.%"]; count == "" || count == "0" {
+			return fmt.Errorf("expected the status page to have components, got %q", count)
+		}
+
+		return nil
+	}
+}
+
+func TestSelectStatusPage(t *testing.T) {
+	statusPages := []client.StatusPageV2{
+		{Id: "page-public", Name: "Acme status"},
+		{Id: "page-internal", Name: "Internal"},
+		{Id: "page-internal-2", Name: "Internal"},
+	}
+
+	// Unset lookups are left as zero values, which are null.
+	testCases := []struct {
+		name        string
+		id          types.String
+		lookupName  types.String
+		expectedID  string
+		expectedErr string
+	}{
+		{name: "by id", id: types.StringValue("page-internal"), expectedID: "page-internal"},
+		{name: "by name", lookupName: types.StringValue("Acme status"), expectedID: "page-public"},
+		{name: "unknown id", id: types.StringValue("page-missing"), expectedErr: `no status page found with id "page-missing"`},
+		{name: "unknown name", lookupName: types.StringValue("Missing"), expectedErr: `no status page found with name "Missing"`},
+		{name: "ambiguous name", lookupName: types.StringValue("Internal"), expectedErr: `found 2 status pages named "Internal"; look it up by id instead`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			statusPage, err := selectStatusPage(statusPages, tc.id, tc.lookupName)
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedID, statusPage.Id)
+		})
+	}
+}
@@ -0,0 +1,554 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &IncidentStatusPageMaintenanceResource{}
+	_ resource.ResourceWithConfigure      = &IncidentStatusPageMaintenanceResource{}
+	_ resource.ResourceWithImportState    = &IncidentStatusPageMaintenanceResource{}
+	_ resource.ResourceWithIdentity       = &IncidentStatusPageMaintenanceResource{}
+	_ resource.ResourceWithModifyPlan     = &IncidentStatusPageMaintenanceResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentStatusPageMaintenanceResource{}
+)
+
+// requiresReplaceUnlessImportedDescription explains the plan modifier on attributes the
+// API only reports once the maintenance has started. Until then an imported maintenance
+// has them null in state, and adopting the configured value shouldn't republish it.
+const requiresReplaceUnlessImportedDescription = "Changing this publishes a new maintenance, unless it's being set for the first time after an import."
+
+var statusPageMaintenanceStatuses = enumValues("StatusPagesCreateStatusPageMaintenancePayloadV2", "maintenance_status")
+
+type IncidentStatusPageMaintenanceResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusPageMaintenanceResourceModel struct {
+	ID                   types.String   `tfsdk:"id"`
+	StatusPageID         types.String   `tfsdk:"status_page_id"`
+	Name                 types.String   `tfsdk:"name"`
+	AffectedComponentIDs types.Set      `tfsdk:"affected_component_ids"`
+	MaintenanceWindowID  types.String   `tfsdk:"maintenance_window_id"`
+	StartAt              types.String   `tfsdk:"start_at"`
+	EndAt                types.String   `tfsdk:"end_at"`
+	Message              types.String   `tfsdk:"message"`
+	MaintenanceStatus    types.String   `tfsdk:"maintenance_status"`
+	NotifySubscribers    types.Bool     `tfsdk:"notify_subscribers"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentStatusPageMaintenanceResource() resource.Resource {
+	return &IncidentStatusPageMaintenanceResource{}
+}
+
+func (r *IncidentStatusPageMaintenanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_maintenance"
+}
+
+func (r *IncidentStatusPageMaintenanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage a maintenance notice on a status page, telling your customers which
+components will be under maintenance and when.
+
+The API can't change a maintenance's name, components or time window once it's
+published, so changing any of those publishes a new maintenance in place of the old one.
+Changing the ` + "`message` or `maintenance_status`" + ` posts an update to the existing maintenance.
+The API can't delete a maintenance either: destroying this resource marks it as complete.
+
+Set ` + "`maintenance_window_id`" + ` to take the time window from an ` + "`incident_maintenance_window`" + `,
+so the public notice moves whenever the internal window does.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("StatusPageMaintenanceV2", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status_page_id": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("StatusPagesCreateStatusPageMaintenancePayloadV2", "status_page_id"),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("StatusPagesCreateStatusPageMaintenancePayloadV2", "name"),
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"affected_component_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the status page components that will be under maintenance. " +
+					"Look them up with the `incident_status_page_structure` data source.",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.SetRequest, resp *setplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, requiresReplaceUnlessImportedDescription, requiresReplaceUnlessImportedDescription),
+				},
+			},
+			"maintenance_window_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of an `incident_maintenance_window` to take `start_at` and `end_at` " +
+					"from. Set either this or both `start_at` and `end_at`.",
+				Optional: true,
+			},
+			"start_at": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("StatusPagesCreateStatusPageMaintenancePayloadV2", "start_at") +
+					". Taken from the maintenance window when `maintenance_window_id` is set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, requiresReplaceUnlessImportedDescription, requiresReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"end_at": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("StatusPagesCreateStatusPageMaintenancePayloadV2", "end_at") +
+					". Taken from the maintenance window when `maintenance_window_id` is set.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, requiresReplaceUnlessImportedDescription, requiresReplaceUnlessImportedDescription),
+				},
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "What's happening during the maintenance, written in markdown. " +
+					"Changing it posts an update to the maintenance.",
+				Required: true,
+			},
+			"maintenance_status": schema.StringAttribute{
+				MarkdownDescription: EnumValuesDescription("StatusPagesCreateStatusPageMaintenancePayloadV2", "maintenance_status") +
+					" Leave this unset to publish the maintenance as scheduled and let the status page move it along.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notify_subscribers": schema.BoolAttribute{
+				MarkdownDescription: "Whether to notify the status page's subscribers when the maintenance is published, and " +
+					"about every update Terraform posts to it. This won't work if your status page has more than 1000 subscribers.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentStatusPageMaintenanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+// ValidateConfig checks that the time window comes from exactly one place, and that a
+// status is one the API accepts.
+func (r *IncidentStatusPageMaintenanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IncidentStatusPageMaintenanceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.MaintenanceWindowID.IsNull() {
+		for _, attr := range []struct {
+			name  string
+			value types.String
+		}{{"start_at", data.StartAt}, {"end_at", data.EndAt}} {
+			if !attr.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Conflicting time window",
+					fmt.Sprintf("%s is taken from the maintenance window when maintenance_window_id is set: remove one or the other.", attr.name),
+				)
+			}
+		}
+	} else {
+		for _, attr := range []struct {
+			name  string
+			value types.String
+		}{{"start_at", data.StartAt}, {"end_at", data.EndAt}} {
+			if attr.value.IsNull() {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Missing time window",
+					fmt.Sprintf("Set %s, or set maintenance_window_id to take the time window from a maintenance window.", attr.name),
+				)
+			}
+		}
+	}
+
+	if status, ok := knownString(data.MaintenanceStatus); ok && !slices.Contains(statusPageMaintenanceStatuses, status) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("maintenance_status"),
+			"Invalid maintenance status",
+			fmt.Sprintf("Expected one of %v, got %q.", statusPageMaintenanceStatuses, status),
+		)
+	}
+}
+
+// ModifyPlan takes the time window from the linked maintenance window. The window can be
+// moved without this resource changing, so this is what notices it has and republishes
+// the maintenance for the new times.
+func (r *IncidentStatusPageMaintenanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var state, plan *IncidentStatusPageMaintenanceResourceModel
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.MaintenanceWindowID.IsNull() {
+		return
+	}
+
+	if plan.MaintenanceWindowID.IsUnknown() {
+		plan.StartAt = types.StringUnknown()
+		plan.EndAt = types.StringUnknown()
+	} else {
+		window, err := r.client.MaintenanceWindowsV1ShowWithResponse(ctx, plan.MaintenanceWindowID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("maintenance_window_id"),
+				"Unable to read maintenance window",
+				fmt.Sprintf("Unable to read maintenance window %s, got error: %s", plan.MaintenanceWindowID.ValueString(), err),
+			)
+			return
+		}
+
+		var priorStartAt, priorEndAt types.String
+		if state != nil {
+			priorStartAt, priorEndAt = state.StartAt, state.EndAt
+		}
+		plan.StartAt = sameInstantOrValue(priorStartAt, window.JSON200.MaintenanceWindow.StartAt)
+		plan.EndAt = sameInstantOrValue(priorEndAt, window.JSON200.MaintenanceWindow.EndAt)
+	}
+
+	if state != nil {
+		if !state.StartAt.IsNull() && !plan.StartAt.Equal(state.StartAt) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("start_at"))
+		}
+		if !state.EndAt.IsNull() && !plan.EndAt.Equal(state.EndAt) {
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("end_at"))
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+func (r *IncidentStatusPageMaintenanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentStatusPageMaintenanceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	// The window's ID wasn't known at plan time, so its times weren't either.
+	if data.StartAt.IsUnknown() || data.EndAt.IsUnknown() {
+		window, err := r.client.MaintenanceWindowsV1ShowWithResponse(ctx, data.MaintenanceWindowID.ValueString())
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read maintenance window", err)
+			return
+		}
+		data.StartAt = types.StringValue(window.JSON200.MaintenanceWindow.StartAt.Format(time.RFC3339))
+		data.EndAt = types.StringValue(window.JSON200.MaintenanceWindow.EndAt.Format(time.RFC3339))
+	}
+
+	startAt, err := time.Parse(time.RFC3339, data.StartAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("start_at"), "Invalid start_at", err.Error())
+		return
+	}
+	endAt, err := time.Parse(time.RFC3339, data.EndAt.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("end_at"), "Invalid end_at", err.Error())
+		return
+	}
+
+	componentIDs := []string{}
+	resp.Diagnostics.Append(data.AffectedComponentIDs.ElementsAs(ctx, &componentIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	status := client.StatusPagesCreateStatusPageMaintenancePayloadV2MaintenanceStatusMaintenanceScheduled
+	if !data.MaintenanceStatus.IsUnknown() && !data.MaintenanceStatus.IsNull() {
+		status = client.StatusPagesCreateStatusPageMaintenancePayloadV2MaintenanceStatus(data.MaintenanceStatus.ValueString())
+	}
+
+	result, err := r.client.StatusPagesV2CreateStatusPageMaintenanceWithResponse(ctx, client.StatusPagesV2CreateStatusPageMaintenanceJSONRequestBody{
+		StatusPageId:         data.StatusPageID.ValueString(),
+		Name:                 data.Name.ValueString(),
+		AffectedComponentIds: componentIDs,
+		StartAt:              startAt,
+		EndAt:                endAt,
+		Message:              data.Message.ValueString(),
+		MaintenanceStatus:    status,
+		NotifySubscribers:    data.NotifySubscribers.ValueBool(),
+		IdempotencyKey:       uuid.NewString(),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create status page maintenance", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a status page maintenance resource with id=%s", result.JSON201.StatusPageMaintenance.Id))
+	data = r.buildModel(*result.JSON201.StatusPageMaintenance, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentStatusPageMaintenanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentStatusPageMaintenanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.StatusPagesV2ShowStatusPageMaintenanceWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Status page maintenance with ID %s not found: removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read status page maintenance", err)
+		return
+	}
+
+	data = r.buildModel(*result.JSON200.StatusPageMaintenance, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+// Update posts an update to the maintenance when the message or status changes. Every
+// other attribute either forces replacement or only affects what Terraform sends.
+func (r *IncidentStatusPageMaintenanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, data *IncidentStatusPageMaintenanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	if data.Message.Equal(state.Message) && data.MaintenanceStatus.Equal(state.MaintenanceStatus) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+		return
+	}
+
+	payload := client.StatusPagesV2CreateStatusPageMaintenanceUpdateJSONRequestBody{
+		StatusPageMaintenanceId: data.ID.ValueString(),
+		Message:                 data.Message.ValueString(),
+		NotifySubscribers:       data.NotifySubscribers.ValueBool(),
+	}
+	if !data.MaintenanceStatus.IsUnknown() && !data.MaintenanceStatus.Equal(state.MaintenanceStatus) {
+		payload.MaintenanceStatus = lo.ToPtr(client.StatusPagesCreateStatusPageMaintenanceUpdatePayloadV2MaintenanceStatus(data.MaintenanceStatus.ValueString()))
+	}
+
+	_, err := r.client.StatusPagesV2CreateStatusPageMaintenanceUpdateWithResponse(ctx, payload)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update status page maintenance", err)
+		return
+	}
+
+	result, err := r.client.StatusPagesV2ShowStatusPageMaintenanceWithResponse(ctx, data.ID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read status page maintenance", err)
+		return
+	}
+
+	data = r.buildModel(*result.JSON200.StatusPageMaintenance, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+// Delete marks the maintenance as complete, as the API has no way to remove one. That
+// takes it off the status page's list of upcoming and ongoing maintenance.
+func (r *IncidentStatusPageMaintenanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentStatusPageMaintenanceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	if data.MaintenanceStatus.ValueString() == string(client.StatusPageMaintenanceV2MaintenanceStatusMaintenanceComplete) {
+		tflog.Info(ctx, fmt.Sprintf("Status page maintenance with ID %s is already complete: removing it from state only.", data.ID.ValueString()))
+		return
+	}
+
+	_, err := r.client.StatusPagesV2CreateStatusPageMaintenanceUpdateWithResponse(ctx, client.StatusPagesV2CreateStatusPageMaintenanceUpdateJSONRequestBody{
+		StatusPageMaintenanceId: data.ID.ValueString(),
+		Message:                 statusPageMaintenanceClosingMessage(data.MaintenanceStatus.ValueString()),
+		MaintenanceStatus:       lo.ToPtr(client.StatusPagesCreateStatusPageMaintenanceUpdatePayloadV2MaintenanceStatusMaintenanceComplete),
+		NotifySubscribers:       data.NotifySubscribers.ValueBool(),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to complete status page maintenance", err)
+		return
+	}
+}
+
+func (r *IncidentStatusPageMaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentStatusPageMaintenanceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// statusPageMaintenanceClosingMessage is the update posted when Terraform destroys a
+// maintenance. One that never started has been called off rather than finished.
+func statusPageMaintenanceClosingMessage(status string) string {
+	if status == string(client.StatusPageMaintenanceV2MaintenanceStatusMaintenanceScheduled) {
+		return "This maintenance is no longer scheduled."
+	}
+
+	return "This maintenance is complete."
+}
+
+// buildModel converts from the response type to the terraform model/schema type. prior
+// is the plan (create/update) or prior state (read).
+//
+// The API doesn't return the scheduled time window or the components it was published
+// against, only the periods each component has actually been under maintenance. Those
+// can differ from what was scheduled, so they only fill in what prior doesn't know, such
+// as after an import.
+func (r *IncidentStatusPageMaintenanceResource) buildModel(maintenance client.StatusPageMaintenanceV2, prior *IncidentStatusPageMaintenanceResourceModel) *IncidentStatusPageMaintenanceResourceModel {
+	model := &IncidentStatusPageMaintenanceResourceModel{
+		ID:                   types.StringValue(maintenance.Id),
+		StatusPageID:         types.StringValue(maintenance.StatusPageId),
+		Name:                 types.StringValue(maintenance.Name),
+		AffectedComponentIDs: prior.AffectedComponentIDs,
+		MaintenanceWindowID:  prior.MaintenanceWindowID,
+		StartAt:              prior.StartAt,
+		EndAt:                prior.EndAt,
+		Message:              prior.Message,
+		MaintenanceStatus:    types.StringValue(string(maintenance.MaintenanceStatus)),
+		NotifySubscribers:    prior.NotifySubscribers,
+		Timeouts:             prior.Timeouts,
+	}
+	if model.NotifySubscribers.IsNull() {
+		model.NotifySubscribers = types.BoolValue(false)
+	}
+
+	if latest, ok := latestStatusPageMaintenanceUpdate(maintenance.Updates); ok {
+		model.Message = types.StringValue(latest.Message)
+	}
+
+	periods := maintenance.ComponentMaintenancePeriods
+
+	if model.AffectedComponentIDs.IsNull() && len(periods) > 0 {
+		componentIDs := lo.Uniq(lo.Map(periods, func(period client.StatusPageMaintenanceComponentMaintenancePeriodV2, _ int) string {
+			return period.ComponentId
+		}))
+		model.AffectedComponentIDs = types.SetValueMust(types.StringType, lo.Map(componentIDs, func(id string, _ int) attr.Value {
+			return types.StringValue(id)
+		}))
+	}
+
+	if len(periods) == 0 {
+		return model
+	}
+
+	if model.StartAt.IsNull() {
+		startAt := lo.MinBy(periods, func(a, b client.StatusPageMaintenanceComponentMaintenancePeriodV2) bool {
+			return a.StartAt.Before(b.StartAt)
+		}).StartAt
+		model.StartAt = types.StringValue(startAt.Format(time.RFC3339))
+	}
+
+	// An ongoing period has no end yet, so there's no end to the window to report.
+	ongoing := lo.SomeBy(periods, func(period client.StatusPageMaintenanceComponentMaintenancePeriodV2) bool {
+		return period.EndAt == nil
+	})
+	if model.EndAt.IsNull() && !ongoing {
+		endAt := *lo.MaxBy(periods, func(a, b client.StatusPageMaintenanceComponentMaintenancePeriodV2) bool {
+			return a.EndAt.After(*b.EndAt)
+		}).EndAt
+		model.EndAt = types.StringValue(endAt.Format(time.RFC3339))
+	}
+
+	return model
+}
+
+// latestStatusPageMaintenanceUpdate returns the most recently published update, whose
+// message is what the status page currently shows.
+func latestStatusPageMaintenanceUpdate(updates []client.StatusPageMaintenanceUpdateV2) (client.StatusPageMaintenanceUpdateV2, bool) {
+	if len(updates) == 0 {
+		return client.StatusPageMaintenanceUpdateV2{}, false
+	}
+
+	return lo.MaxBy(updates, func(a, b client.StatusPageMaintenanceUpdateV2) bool {
+		return a.PublishedAt.After(b.PublishedAt)
+	}), true
+}
@@ -0,0 +1,202 @@
+package provider
+
+import (
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// TestAccIncidentStatusPageMaintenanceResource publishes a maintenance against the first
+// component of an existing status page, updates its message, and completes it on destroy.
+//
+// NOTE: The provider can't create status pages, and this publishes to a real one. Set
+// TF_ACC_STATUS_PAGE_NAME to the name of a status page with components to run it.
+func TestAccIncidentStatusPageMaintenanceResource(t *testing.T) {
+	statusPageName := os.Getenv("TF_ACC_STATUS_PAGE_NAME")
+	if statusPageName == "" {
+		t.Skip("TF_ACC_STATUS_PAGE_NAME is not set: skipping test that requires an existing status page")
+	}
+
+	startAt := testAccNow(t).Add(7 * 24 * time.Hour).UTC().Truncate(time.Hour)
+	args := map[string]any{
+		"StatusPageName": statusPageName,
+		"Name":           StableSuffix("Database upgrade"),
+		"StartAt":        startAt.Format(time.RFC3339),
+		"EndAt":          startAt.Add(2 * time.Hour).Format(time.RFC3339),
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccStatusPageMaintenanceResourceConfig(t, args, "We're upgrading our database."),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"incident_status_page_maintenance.example", "id", regexp.MustCompile("^[a-zA-Z0-9]+$")),
+					resource.TestCheckResourceAttr(
+						"incident_status_page_maintenance.example", "name", args["Name"].(string)),
+					resource.TestCheckResourceAttr(
+						"incident_status_page_maintenance.example", "start_at", args["StartAt"].(string)),
+					resource.TestCheckResourceAttr(
+						"incident_status_page_maintenance.example", "maintenance_status", "maintenance_scheduled"),
+					resource.TestCheckResourceAttr(
+						"incident_status_page_maintenance.example", "message", "We're upgrading our database."),
+				),
+			},
+			// Ensure no drift after refresh
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+				RefreshPlanChecks: resource.RefreshPlanChecks{
+					PostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Import
+			{
+				ResourceName:      "incident_status_page_maintenance.example",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// The API only reports the window each component has actually been under
+				// maintenance, and nothing has started yet. The next plan adopts the
+				// configured values without republishing.
+				ImportStateVerifyIgnore: []string{"start_at", "end_at", "affected_component_ids"},
+			},
+			// Changing the message posts an update in place
+			{
+				Config: testAccStatusPageMaintenanceResourceConfig(t, args, "We've moved the upgrade to reduce impact."),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("incident_status_page_maintenance.example", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_status_page_maintenance.example", "message", "We've moved the upgrade to reduce impact."),
+				),
+			},
+		},
+	})
+}
+
+var statusPageMaintenanceTemplate = `
+data "incident_status_page" "main" {
+  name = {{ quote .StatusPageName }}
+}
+
+data "incident_status_page_structure" "main" {
+  status_page_id = data.incident_status_page.main.id
+}
+
+resource "incident_status_page_maintenance" "example" {
+  status_page_id         = data.incident_status_page.main.id
+  name                   = {{ quote .Name }}
+  affected_component_ids = [values(data.incident_status_page_structure.main.components)[0].id]
+  start_at               = {{ quote .StartAt }}
+  end_at                 = {{ quote .EndAt }}
+  message                = {{ quote .Message }}
+}
+`
+
+func testAccStatusPageMaintenanceResourceConfig(t *testing.T, args map[string]any, message string) string {
+	withMessage := map[string]any{"Message": message}
+	for key, value := range args {
+		withMessage[key] = value
+	}
+
+	return testRunTemplate(t.Name(), statusPageMaintenanceTemplate, withMessage)
+}
+
+func TestStatusPageMaintenanceBuildModel(t *testing.T) {
+	start := time.Date(2026, 11, 1, 9, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+
+	maintenance := client.StatusPageMaintenanceV2{
+		Id:                "01MAINTENANCE",
+		StatusPageId:      "01STATUSPAGE",
+		Name:              "Database upgrade",
+		MaintenanceStatus: client.StatusPageMaintenanceV2MaintenanceStatusMaintenanceInProgress,
+		ComponentMaintenancePeriods: []client.StatusPageMaintenanceComponentMaintenancePeriodV2{
+			{ComponentId: "01API", StartAt: start.Add(time.Hour), EndAt: &end},
+			{ComponentId: "01DASHBOARD", StartAt: start, EndAt: &end},
+			{ComponentId: "01API", StartAt: start, EndAt: &end},
+		},
+		Updates: []client.StatusPageMaintenanceUpdateV2{
+			{Message: "We're upgrading our database.", PublishedAt: start.Add(-time.Hour)},
+			{Message: "The upgrade has started.", PublishedAt: start},
+		},
+	}
+
+	r := &IncidentStatusPageMaintenanceResource{}
+
+	t.Run("fills in what an import doesn't know from the periods", func(t *testing.T) {
+		model := r.buildModel(maintenance, &IncidentStatusPageMaintenanceResourceModel{
+			AffectedComponentIDs: types.SetNull(types.StringType),
+		})
+
+		assert.Equal(t, "01MAINTENANCE", model.ID.ValueString())
+		assert.Equal(t, "maintenance_in_progress", model.MaintenanceStatus.ValueString())
+		assert.Equal(t, "The upgrade has started.", model.Message.ValueString())
+		assert.Equal(t, start.Format(time.RFC3339), model.StartAt.ValueString())
+		assert.Equal(t, end.Format(time.RFC3339), model.EndAt.ValueString())
+		assert.Len(t, model.AffectedComponentIDs.Elements(), 2)
+		assert.False(t, model.NotifySubscribers.ValueBool())
+	})
+
+	t.Run("keeps the scheduled window from prior", func(t *testing.T) {
+		scheduled := start.Add(-30 * time.Minute).Format(time.RFC3339)
+		components := types.SetValueMust(types.StringType, nil)
+		model := r.buildModel(maintenance, &IncidentStatusPageMaintenanceResourceModel{
+			AffectedComponentIDs: components,
+			StartAt:              types.StringValue(scheduled),
+			EndAt:                types.StringValue(scheduled),
+			NotifySubscribers:    types.BoolValue(true),
+		})
+
+		assert.Equal(t, scheduled, model.StartAt.ValueString())
+		assert.Equal(t, scheduled, model.EndAt.ValueString())
+		assert.Equal(t, components, model.AffectedComponentIDs)
+		assert.True(t, model.NotifySubscribers.ValueBool())
+	})
+
+	t.Run("leaves the end unset while a component is still under maintenance", func(t *testing.T) {
+		ongoing := maintenance
+		ongoing.ComponentMaintenancePeriods = []client.StatusPageMaintenanceComponentMaintenancePeriodV2{
+			{ComponentId: "01API", StartAt: start},
+		}
+		model := r.buildModel(ongoing, &IncidentStatusPageMaintenanceResourceModel{
+			AffectedComponentIDs: types.SetNull(types.StringType),
+		})
+
+		assert.Equal(t, start.Format(time.RFC3339), model.StartAt.ValueString())
+		assert.True(t, model.EndAt.IsNull())
+	})
+
+	t.Run("leaves what it can't know null before the maintenance starts", func(t *testing.T) {
+		scheduled := maintenance
+		scheduled.ComponentMaintenancePeriods = nil
+		model := r.buildModel(scheduled, &IncidentStatusPageMaintenanceResourceModel{
+			AffectedComponentIDs: types.SetNull(types.StringType),
+		})
+
+		assert.True(t, model.AffectedComponentIDs.IsNull())
+		assert.True(t, model.StartAt.IsNull())
+		assert.True(t, model.EndAt.IsNull())
+	})
+}
+
+func TestStatusPageMaintenanceClosingMessage(t *testing.T) {
+	assert.Equal(t, "This maintenance is no longer scheduled.", statusPageMaintenanceClosingMessage("maintenance_scheduled"))
+	assert.Equal(t, "This maintenance is complete.", statusPageMaintenanceClosingMessage("maintenance_in_progress"))
+}
@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestStatusPageMaintenanceValidateConfig(t *testing.T) {
+	null := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	timestamp := tftypes.NewValue(tftypes.String, "2026-11-01T09:00:00Z")
+
+	testCases := []struct {
+		name      string
+		overrides map[string]tftypes.Value
+		expectErr bool
+	}{
+		{
+			name:      "explicit window is fine",
+			overrides: map[string]tftypes.Value{"start_at": timestamp, "end_at": timestamp},
+		},
+		{
+			name:      "maintenance window alone is fine",
+			overrides: map[string]tftypes.Value{"maintenance_window_id": tftypes.NewValue(tftypes.String, "01WINDOW")},
+		},
+		{
+			name:      "unknown maintenance window is fine",
+			overrides: map[string]tftypes.Value{"maintenance_window_id": unknown},
+		},
+		{
+			name: "maintenance window with start_at is rejected",
+			overrides: map[string]tftypes.Value{
+				"maintenance_window_id": tftypes.NewValue(tftypes.String, "01WINDOW"),
+				"start_at":              timestamp,
+			},
+			expectErr: true,
+		},
+		{
+			name:      "missing end_at is rejected",
+			overrides: map[string]tftypes.Value{"start_at": timestamp, "end_at": null},
+			expectErr: true,
+		},
+		{
+			name:      "no window at all is rejected",
+			overrides: map[string]tftypes.Value{},
+			expectErr: true,
+		},
+		{
+			name: "known status is fine",
+			overrides: map[string]tftypes.Value{
+				"start_at":           timestamp,
+				"end_at":             timestamp,
+				"maintenance_status": tftypes.NewValue(tftypes.String, "maintenance_in_progress"),
+			},
+		},
+		{
+			name: "unrecognised status is rejected",
+			overrides: map[string]tftypes.Value{
+				"start_at":           timestamp,
+				"end_at":             timestamp,
+				"maintenance_status": tftypes.NewValue(tftypes.String, "in_progress"),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateStatusPageMaintenance(t, tc.overrides)
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("expected error: %v, got diagnostics: %+v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func validateStatusPageMaintenance(t *testing.T, overrides map[string]tftypes.Value) resource.ValidateConfigResponse {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	NewIncidentStatusPageMaintenanceResource().Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+
+	attributes := map[string]tftypes.Value{
+		"id":             tftypes.NewValue(tftypes.String, nil),
+		"status_page_id": tftypes.NewValue(tftypes.String, "01STATUSPAGE"),
+		"name":           tftypes.NewValue(tftypes.String, "Database upgrade"),
+		"affected_component_ids": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+			tftypes.NewValue(tftypes.String, "01COMPONENT"),
+		}),
+		"maintenance_window_id": tftypes.NewValue(tftypes.String, nil),
+		"start_at":              tftypes.NewValue(tftypes.String, nil),
+		"end_at":                tftypes.NewValue(tftypes.String, nil),
+		"message":               tftypes.NewValue(tftypes.String, "We're upgrading our database."),
+		"maintenance_status":    tftypes.NewValue(tftypes.String, nil),
+		"notify_subscribers":    tftypes.NewValue(tftypes.Bool, nil),
+		"timeouts":              tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
+	}
+	for name, value := range overrides {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("override %q isn't an attribute on the status page maintenance schema", name)
+		}
+		attributes[name] = value
+	}
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attributes),
+	}
+
+	r, ok := NewIncidentStatusPageMaintenanceResource().(*IncidentStatusPageMaintenanceResource)
+	if !ok {
+		t.Fatalf("NewIncidentStatusPageMaintenanceResource did not return a *IncidentStatusPageMaintenanceResource")
+	}
+
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+	return resp
+}
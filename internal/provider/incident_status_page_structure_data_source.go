@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentStatusPageStructureDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentStatusPageStructureDataSource{}
+)
+
+func NewIncidentStatusPageStructureDataSource() datasource.DataSource {
+	return &IncidentStatusPageStructureDataSource{}
+}
+
+type IncidentStatusPageStructureDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusPageStructureDataSourceModel struct {
+	StatusPageID types.String                                `tfsdk:"status_page_id"`
+	Components   map[string]IncidentStatusPageComponentModel `tfsdk:"components"`
+	Groups       map[string]IncidentStatusPageGroupModel     `tfsdk:"groups"`
+	SubPages     map[string]IncidentStatusPageSubPageModel   `tfsdk:"sub_pages"`
+}
+
+type IncidentStatusPageComponentModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	GroupID   types.String `tfsdk:"group_id"`
+	SubPageID types.String `tfsdk:"sub_page_id"`
+}
+
+type IncidentStatusPageGroupModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	ComponentIDs []types.String `tfsdk:"component_ids"`
+	SubPageID    types.String   `tfsdk:"sub_page_id"`
+}
+
+type IncidentStatusPageSubPageModel struct {
+	ID   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *IncidentStatusPageStructureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page_structure"
+}
+
+func (d *IncidentStatusPageStructureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Read the components, groups and sub-pages of a status page, keyed by name, so that
+workflow steps can refer to them without hard-coding their IDs.
+
+Names only need to be unique within a group or sub-page. When two components, groups or
+sub-pages share a name, the first one on the page is used and the plan warns about the rest.`,
+		Attributes: map[string]schema.Attribute{
+			"status_page_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The ID of the status page to read the structure of.",
+			},
+			"components": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every component on the status page, including those in groups and sub-pages, keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureComponentV2", "component_id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureComponentV2", "name"),
+						},
+						"group_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the group the component belongs to, if any.",
+						},
+						"sub_page_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the sub-page the component belongs to, if any.",
+						},
+					},
+				},
+			},
+			"groups": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every component group on the status page, including those in sub-pages, keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureGroupV2", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureGroupV2", "name"),
+						},
+						"component_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The IDs of the components in the group, in the order they're displayed.",
+						},
+						"sub_page_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the sub-page the group belongs to, if any.",
+						},
+					},
+				},
+			},
+			"sub_pages": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every sub-page of the status page, keyed by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureSubPageV2", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("StatusPageStructureSubPageV2", "name"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentStatusPageStructureDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+func (d *IncidentStatusPageStructureDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusPageStructureDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.StatusPagesV2ShowStatusPageStructureWithResponse(ctx, data.StatusPageID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read status page structure", err)
+		return
+	}
+	if result.JSON200 == nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read status page structure", fmt.Errorf("unexpected response: %s", result.Status()))
+		return
+	}
+
+	model, duplicates := buildStatusPageStructureModel(result.JSON200.CurrentStructure)
+	for _, duplicate := range duplicates {
+		resp.Diagnostics.AddWarning("Duplicate name in status page structure", duplicate)
+	}
+
+	model.StatusPageID = data.StatusPageID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// buildStatusPageStructureModel flattens the nested structure into maps keyed by name.
+// When names collide it keeps the first item on the page, and describes each one it
+// skipped in the returned slice.
+func buildStatusPageStructureModel(structure client.StatusPageStructureV2) (IncidentStatusPageStructureDataSourceModel, []string) {
+	model := IncidentStatusPageStructureDataSourceModel{
+		Components: map[string]IncidentStatusPageComponentModel{},
+		Groups:     map[string]IncidentStatusPageGroupModel{},
+		SubPages:   map[string]IncidentStatusPageSubPageModel{},
+	}
+	duplicates := []string{}
+
+	addComponent := func(component client.StatusPageStructureComponentV2, groupID, subPageID types.String) {
+		if existing, ok := model.Components[component.Name]; ok {
+			duplicates = append(duplicates, fmt.Sprintf(
+				"Several components are named %q. components[%q] is %s: use its ID directly to refer to %s.",
+				component.Name, component.Name, existing.ID.ValueString(), component.ComponentId))
+			return
+		}
+		model.Components[component.Name] = IncidentStatusPageComponentModel{
+			ID:        types.StringValue(component.ComponentId),
+			Name:      types.StringValue(component.Name),
+			GroupID:   groupID,
+			SubPageID: subPageID,
+		}
+	}
+
+	addGroup := func(group client.StatusPageStructureGroupV2, subPageID types.String) {
+		componentIDs := []types.String{}
+		for _, component := range group.Components {
+			componentIDs = append(componentIDs, types.StringValue(component.ComponentId))
+			addComponent(component, types.StringValue(group.Id), subPageID)
+		}
+
+		if existing, ok := model.Groups[group.Name]; ok {
+			duplicates = append(duplicates, fmt.Sprintf(
+				"Several groups are named %q. groups[%q] is %s: use its ID directly to refer to %s.",
+				group.Name, group.Name, existing.ID.ValueString(), group.Id))
+			return
+		}
+		model.Groups[group.Name] = IncidentStatusPageGroupModel{
+			ID:           types.StringValue(group.Id),
+			Name:         types.StringValue(group.Name),
+			ComponentIDs: componentIDs,
+			SubPageID:    subPageID,
+		}
+	}
+
+	for _, item := range structure.Items {
+		switch {
+		case item.Component != nil:
+			addComponent(*item.Component, types.StringNull(), types.StringNull())
+		case item.Group != nil:
+			addGroup(*item.Group, types.StringNull())
+		case item.SubPage != nil:
+			subPage := *item.SubPage
+			if existing, ok := model.SubPages[subPage.Name]; ok {
+				duplicates = append(duplicates, fmt.Sprintf(
+					"Several sub-pages are named %q. sub_pages[%q] is %s: use its ID directly to refer to %s.",
+					subPage.Name, subPage.Name, existing.ID.ValueString(), subPage.Id))
+			} else {
+				model.SubPages[subPage.Name] = IncidentStatusPageSubPageModel{
+					ID:   types.StringValue(subPage.Id),
+					Name: types.StringValue(subPage.Name),
+				}
+			}
+
+			subPageID := types.StringValue(subPage.Id)
+			for _, subPageItem := range subPage.Items {
+				switch {
+				case subPageItem.Component != nil:
+					addComponent(*subPageItem.Component, types.StringNull(), subPageID)
+				case subPageItem.Group != nil:
+					addGroup(*subPageItem.Group, subPageID)
+				}
+			}
+		}
+	}
+
+	return model, duplicates
+}
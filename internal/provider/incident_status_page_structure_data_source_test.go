@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestBuildStatusPageStructureModel(t *testing.T) {
+	component := func(id, name string) client.StatusPageStructureComponentV2 {
+		return client.StatusPageStructureComponentV2{ComponentId: id, Name: name}
+	}
+
+	structure := client.StatusPageStructureV2{
+		Items: []client.StatusPageStructureItemV2{
+			{Component: &client.StatusPageStructureComponentV2{ComponentId: "comp-website", Name: "Website"}},
+			{Group: &client.StatusPageStructureGroupV2{
+				Id:   "group-platform",
+				Name: "Platform",
+				Components: []client.StatusPageStructureComponentV2{
+					component("comp-api", "API"),
+					component("comp-webhooks", "Webhooks"),
+				},
+			}},
+			{SubPage: &client.StatusPageStructureSubPageV2{
+				Id:   "sub-eu",
+				Name: "EU",
+				Items: []client.StatusPageStructureSubPageItemV2{
+					{Component: &client.StatusPageStructureComponentV2{ComponentId: "comp-eu-dashboard", Name: "Dashboard"}},
+					{Group: &client.StatusPageStructureGroupV2{
+						Id:   "group-eu-platform",
+						Name: "Platform",
+						Components: []client.StatusPageStructureComponentV2{
+							component("comp-eu-api", "API"),
+						},
+					}},
+				},
+			}},
+		},
+	}
+
+	model, duplicates := buildStatusPageStructureModel(structure)
+
+	assert.Equal(t, map[string]IncidentStatusPageComponentModel{
+		"Website": {
+			ID:        types.StringValue("comp-website"),
+			Name:      types.StringValue("Website"),
+			GroupID:   types.StringNull(),
+			SubPageID: types.StringNull(),
+		},
+		"API": {
+			ID:        types.StringValue("comp-api"),
+			Name:      types.StringValue("API"),
+			GroupID:   types.StringValue("group-platform"),
+			SubPageID: types.StringNull(),
+		},
+		"Webhooks": {
+			ID:        types.StringValue("comp-webhooks"),
+			Name:      types.StringValue("Webhooks"),
+			GroupID:   types.StringValue("group-platform"),
+			SubPageID: types.StringNull(),
+		},
+		"Dashboard": {
+			ID:        types.StringValue("comp-eu-dashboard"),
+			Name:      types.StringValue("Dashboard"),
+			GroupID:   types.StringNull(),
+			SubPageID: types.StringValue("sub-eu"),
+		},
+	}, model.Components)
+
+	assert.Equal(t, map[string]IncidentStatusPageGroupModel{
+		"Platform": {
+			ID:           types.StringValue("group-platform"),
+			Name:         types.StringValue("Platform"),
+			ComponentIDs: []types.String{types.StringValue("comp-api"), types.StringValue("comp-webhooks")},
+			SubPageID:    types.StringNull(),
+		},
+	}, model.Groups)
+
+	assert.Equal(t, map[string]IncidentStatusPageSubPageModel{
+		"EU": {ID: types.StringValue("sub-eu"), Name: types.StringValue("EU")},
+	}, model.SubPages)
+
+	// The EU sub-page reuses names from the main page, so the first of each wins.
+	assert.Equal(t, []string{
+		`Several components are named "API". components["API"] is comp-api: use its ID directly to refer to comp-eu-api.`,
+		`Several groups are named "Platform". groups["Platform"] is group-platform: use its ID directly to refer to group-eu-platform.`,
+	}, duplicates)
+}
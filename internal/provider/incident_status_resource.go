@@ -5,7 +5,8 @@ import (
 	"errors"
 	"fmt"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,6 +21,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentStatusResource{}
 	_ resource.ResourceWithImportState = &IncidentStatusResource{}
+	_ resource.ResourceWithIdentity    = &IncidentStatusResource{}
 )
 
 type IncidentStatusResource struct {
@@ -27,16 +29,21 @@ type IncidentStatusResource struct {
 }
 
 type IncidentStatusResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Category    types.String `tfsdk:"category"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Category    types.String   `tfsdk:"category"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewIncidentStatusResource() resource.Resource {
 	return &IncidentStatusResource{}
 }
 
+func NewIncidentStatusListResource() list.ListResource {
+	return newIDListResource(NewIncidentStatusResource, listStatusResources)
+}
+
 func (r *IncidentStatusResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_status"
 }
@@ -68,6 +75,9 @@ func (r *IncidentStatusResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -96,19 +106,25 @@ func (r *IncidentStatusResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentStatusesV1CreateWithResponse(ctx, client.IncidentStatusesV1CreateJSONRequestBody{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 		Category:    client.IncidentStatusesCreatePayloadV1Category(data.Category.ValueString()),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create incident status, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create incident status", err)
 		return
 	}
 
 	tflog.Trace(ctx, fmt.Sprintf("created an incident status resource with id=%s", result.JSON201.IncidentStatus.Id))
-	data = r.buildModel(result.JSON201.IncidentStatus)
+	model := r.buildModel(result.JSON201.IncidentStatus)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentStatusResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -118,6 +134,9 @@ func (r *IncidentStatusResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentStatusesV1ShowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
 		// Check if error message contains any indication of a 404 not found
@@ -127,12 +146,15 @@ func (r *IncidentStatusResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read incident status, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read incident status", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentStatus)
+	model := r.buildModel(result.JSON200.IncidentStatus)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentStatusResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -142,17 +164,23 @@ func (r *IncidentStatusResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.IncidentStatusesV1UpdateWithResponse(ctx, data.ID.ValueString(), client.IncidentStatusesV1UpdateJSONRequestBody{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update incident status, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update incident status", err)
 		return
 	}
 
-	data = r.buildModel(result.JSON200.IncidentStatus)
+	model := r.buildModel(result.JSON200.IncidentStatus)
+	model.Timeouts = data.Timeouts
+	data = model
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentStatusResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -162,15 +190,22 @@ func (r *IncidentStatusResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.IncidentStatusesV1DeleteWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete incident status, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete incident status", err)
 		return
 	}
 }
 
 func (r *IncidentStatusResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importStateByID(ctx, req, resp)
+}
+
+func (r *IncidentStatusResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentStatusResource) buildModel(status client.IncidentStatusV1) *IncidentStatusResourceModel {
@@ -181,3 +216,26 @@ func (r *IncidentStatusResource) buildModel(status client.IncidentStatusV1) *Inc
 		Category:    types.StringValue(string(status.Category)),
 	}
 }
+
+// listStatusResources lists the incident statuses in the categories that can be created,
+// for `terraform query`. Statuses in other categories, such as triage and declined, are
+// built in: they can't be created, so there's nothing to manage.
+func listStatusResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.IncidentStatusesV1ListWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing statuses: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.IncidentStatuses {
+		if !client.IncidentStatusesCreatePayloadV1Category(item.Category).Valid() {
+			continue
+		}
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
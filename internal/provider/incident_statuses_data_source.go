@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentStatusesDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentStatusesDataSource{}
+)
+
+func NewIncidentStatusesDataSource() datasource.DataSource {
+	return &IncidentStatusesDataSource{}
+}
+
+type IncidentStatusesDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentStatusesDataSourceModel struct {
+	Category types.String                             `tfsdk:"category"`
+	All      map[string]IncidentStatusDataSourceModel `tfsdk:"all"`
+}
+
+func (d *IncidentStatusesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *IncidentProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client.Client
+}
+
+func (d *IncidentStatusesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_statuses"
+}
+
+func (d *IncidentStatusesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentStatusesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := d.client.IncidentStatusesV1ListWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list incident statuses", err)
+		return
+	}
+
+	// Status names are unique, so they make a stable key to look statuses up by.
+	data.All = map[string]IncidentStatusDataSourceModel{}
+	for _, status := range result.JSON200.IncidentStatuses {
+		if !data.Category.IsNull() && string(status.Category) != data.Category.ValueString() {
+			continue
+		}
+
+		data.All[status.Name] = buildIncidentStatusDataSourceModel(status)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (d *IncidentStatusesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This data source provides the incident statuses in your organisation, keyed by name.",
+		Attributes: map[string]schema.Attribute{
+			"category": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only return statuses in this category, such as `live` or `closed`.",
+			},
+			"all": schema.MapNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The matching statuses, keyed by their name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1", "name"),
+						},
+						"category": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: EnumValuesDescription("IncidentStatusV1", "category"),
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1", "description"),
+						},
+						"rank": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("IncidentStatusV1", "rank"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentTeamDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentTeamDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentTeamDataSource{}
+)
+
+// teamLookupPageSize is the page size used when listing teams. It's the maximum the
+// endpoint allows, so most organisations resolve in a single request.
+const teamLookupPageSize = 250
+
+func NewIncidentTeamDataSource() datasource.DataSource {
+	return &IncidentTeamDataSource{}
+}
+
+type IncidentTeamDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentTeamDataSourceModel struct {
+	ID             types.String              `tfsdk:"id"`
+	Name           types.String              `tfsdk:"name"`
+	CatalogEntryID types.String              `tfsdk:"catalog_entry_id"`
+	Members        []IncidentTeamMemberModel `tfsdk:"members"`
+}
+
+type IncidentTeamMemberModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Email       types.String `tfsdk:"email"`
+	SlackUserID types.String `tfsdk:"slack_user_id"`
+}
+
+func (d *IncidentTeamDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *IncidentTeamDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up a team by `id` or `name`. Exactly one lookup field should be set.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Look up the team by ID.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Look up the team by name. This fails if no team, or more than one team, has the name.",
+			},
+			"catalog_entry_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the catalog entry that represents the team.",
+			},
+			"members": teamMembersAttribute(),
+		},
+	}
+}
+
+// teamMembersAttribute is shared by the team data sources, so a team's members look
+// the same whichever one you read them from.
+func teamMembersAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Computed:            true,
+		MarkdownDescription: apischema.Docstring("TeamV3", "members"),
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: apischema.Docstring("UserV3", "id"),
+				},
+				"name": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: apischema.Docstring("UserV3", "name"),
+				},
+				"email": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: apischema.Docstring("UserV3", "email"),
+				},
+				"slack_user_id": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: apischema.Docstring("UserV3", "slack_user_id"),
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentTeamDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+// ValidateConfig rejects an ambiguous lookup at plan time. Both attributes are
+// Optional and Computed so either can be used, which means setting both would
+// otherwise silently ignore one of them.
+func (d *IncidentTeamDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data *IncidentTeamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data == nil {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	switch {
+	case !data.ID.IsNull() && !data.Name.IsNull():
+		resp.Diagnostics.AddError("Ambiguous lookup", "Set either id or name, not both.")
+	case data.ID.IsNull() && data.Name.IsNull():
+		resp.Diagnostics.AddError("Missing lookup", "Set one of id or name.")
+	}
+}
+
+func (d *IncidentTeamDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentTeamDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var team *client.TeamV3
+	switch {
+	case !data.ID.IsNull():
+		result, err := d.client.TeamsV3ShowWithResponse(ctx, data.ID.ValueString())
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read team", err)
+			return
+		}
+		if result.JSON200 == nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read team", fmt.Errorf("unexpected response: %s", result.Status()))
+			return
+		}
+		team = &result.JSON200.Team
+	case !data.Name.IsNull():
+		got, err := d.findByName(ctx, data.Name.ValueString())
+		if err != nil {
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read team by name", err)
+			return
+		}
+		team = got
+	default:
+		resp.Diagnostics.AddError("Missing lookup", "Set one of id or name.")
+		return
+	}
+
+	modelResp := buildTeamDataSourceModel(*team)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &modelResp)...)
+}
+
+// findByName lists every team looking for an exact name match, and requires exactly
+// one. The list endpoint has no name filter.
+func (d *IncidentTeamDataSource) findByName(ctx context.Context, name string) (*client.TeamV3, error) {
+	teams, err := listTeams(ctx, d.client)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := lo.Filter(teams, func(team client.TeamV3, _ int) bool {
+		return team.Name == name
+	})
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no team found with name %q", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("found %d teams named %q; look it up by id instead", len(matches), name)
+	}
+}
+
+// listTeams pages through every team. The endpoint only returns a cursor while pages
+// are full, so this terminates on the last page.
+func listTeams(ctx context.Context, apiClient *client.ClientWithResponses) ([]client.TeamV3, error) {
+	var (
+		after *string
+		teams []client.TeamV3
+	)
+
+	for {
+		result, err := apiClient.TeamsV3ListWithResponse(ctx, &client.TeamsV3ListParams{
+			PageSize: lo.ToPtr(int64(teamLookupPageSize)),
+			After:    after,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response listing teams: %s", result.Status())
+		}
+
+		teams = append(teams, result.JSON200.Teams...)
+
+		after = result.JSON200.PaginationMeta.After
+		if after == nil {
+			break
+		}
+	}
+
+	return teams, nil
+}
+
+func buildTeamDataSourceModel(team client.TeamV3) IncidentTeamDataSourceModel {
+	members := []IncidentTeamMemberModel{}
+	for _, member := range team.Members {
+		members = append(members, IncidentTeamMemberModel{
+			ID:          types.StringValue(member.Id),
+			Name:        types.StringValue(member.Name),
+			Email:       types.StringPointerValue(member.Email),
+			SlackUserID: types.StringPointerValue(member.SlackUserId),
+		})
+	}
+
+	return IncidentTeamDataSourceModel{
+		ID:             types.StringValue(team.Id),
+		Name:           types.StringValue(team.Name),
+		CatalogEntryID: types.StringValue(team.CatalogEntry.Id),
+		Members:        members,
+	}
+}
@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// TestAccIncidentTeamDataSource looks up whichever team the test organisation has first,
+// as the provider can't create teams.
+func TestAccIncidentTeamDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_teams" "all" {}
+
+data "incident_team" "by_name" {
+  count = length(data.incident_teams.all.teams) > 0 ? 1 : 0
+  name  = data.incident_teams.all.teams[0].name
+}
+
+data "incident_team" "by_id" {
+  count = length(data.incident_teams.all.teams) > 0 ? 1 : 0
+  id    = data.incident_teams.all.teams[0].id
+}
+`,
+				Check: testAccCheckFirstTeamLookups,
+			},
+		},
+	})
+}
+
+func testAccCheckFirstTeamLookups(s *terraform.State) error {
+	teams, ok := s.RootModule().Resources["data.incident_teams.all"]
+	if !ok {
+		return fmt.Errorf("data.incident_teams.all not found")
+	}
+	if teams.Primary.Attributes["teams.#"] == "0" {
+		return nil
+	}
+
+	for _, name := range []string{"data.incident_team.by_name.0", "data.incident_team.by_id.0"} {
+		team, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%s not found", name)
+		}
+		for _, attr := range []string{"id", "name", "catalog_entry_id", "members.#"} {
+			if got, want := team.Primary.Attributes[attr], teams.Primary.Attributes["teams.0."+attr]; got != want {
+				return fmt.Errorf("%s: expected %s to be %q, got %q", name, attr, want, got)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateTeamDataSource(t *testing.T, id, name tftypes.Value) datasource.ValidateConfigResponse {
+	t.Helper()
+
+	var schemaResp datasource.SchemaResponse
+	NewIncidentTeamDataSource().Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+			"id":               id,
+			"name":             name,
+			"catalog_entry_id": tftypes.NewValue(tftypes.String, nil),
+			"members":          tftypes.NewValue(objType.AttributeTypes["members"], nil),
+		}),
+	}
+
+	d, ok := NewIncidentTeamDataSource().(*IncidentTeamDataSource)
+	if !ok {
+		t.Fatalf("NewIncidentTeamDataSource did not return a *IncidentTeamDataSource")
+	}
+	var resp datasource.ValidateConfigResponse
+	d.ValidateConfig(context.Background(), datasource.ValidateConfigRequest{Config: config}, &resp)
+	return resp
+}
+
+func TestTeamDataSourceValidateConfig(t *testing.T) {
+	null := tftypes.NewValue(tftypes.String, nil)
+	unknown := tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	value := tftypes.NewValue(tftypes.String, "01TEAM")
+
+	testCases := []struct {
+		name      string
+		id        tftypes.Value
+		teamName  tftypes.Value
+		expectErr bool
+	}{
+		{name: "id alone is fine", id: value, teamName: null},
+		{name: "name alone is fine", id: null, teamName: value},
+		{name: "both set is rejected", id: value, teamName: value, expectErr: true},
+		{name: "neither set is rejected", id: null, teamName: null, expectErr: true},
+		{name: "unknown alongside a value is left alone", id: unknown, teamName: value},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateTeamDataSource(t, tc.id, tc.teamName)
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("expected error: %v, got diagnostics: %+v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentTeamsDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentTeamsDataSource{}
+)
+
+func NewIncidentTeamsDataSource() datasource.DataSource {
+	return &IncidentTeamsDataSource{}
+}
+
+type IncidentTeamsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentTeamsDataSourceModel struct {
+	Teams []IncidentTeamDataSourceModel `tfsdk:"teams"`
+}
+
+func (d *IncidentTeamsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *IncidentTeamsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List every team in your organisation, with their members.",
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Every team, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("TeamV3", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("TeamV3", "name"),
+						},
+						"catalog_entry_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The ID of the catalog entry that represents the team.",
+						},
+						"members": teamMembersAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentTeamsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+func (d *IncidentTeamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	teams, err := listTeams(ctx, d.client)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list teams", err)
+		return
+	}
+
+	data := IncidentTeamsDataSourceModel{
+		Teams: []IncidentTeamDataSourceModel{},
+	}
+	for _, team := range teams {
+		data.Teams = append(data.Teams, buildTeamDataSourceModel(team))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -72,7 +72,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 		result, err := i.client.UsersV2ShowWithResponse(ctx, data.ID.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read user", err)
 			return
 		}
 		user = &result.JSON200.User
@@ -84,7 +84,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 			IncludeInactive: lo.ToPtr(true),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read user", err)
 			return
 		}
 		match, err := selectUserByEmail(result.JSON200.Users)
@@ -110,7 +110,7 @@ func (i *IncidentUserDataSource) Read(ctx context.Context, req datasource.ReadRe
 			IncludeInactive: lo.ToPtr(true),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user, got error: %s", err))
+			addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read user", err)
 			return
 		}
 		if len(result.JSON200.Users) == 0 {
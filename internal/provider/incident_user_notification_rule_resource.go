@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &IncidentUserNotificationRuleResource{}
+	_ resource.ResourceWithConfigure      = &IncidentUserNotificationRuleResource{}
+	_ resource.ResourceWithImportState    = &IncidentUserNotificationRuleResource{}
+	_ resource.ResourceWithIdentity       = &IncidentUserNotificationRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &IncidentUserNotificationRuleResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentUserNotificationRuleResource{}
+)
+
+var (
+	notificationRuleTypes         = enumValues("OnCallNotificationRuleCreatePayloadPublicV2", "rule_type")
+	notificationRuleMethodTypes   = enumValues("OnCallNotificationRuleCreatePayloadPublicV2", "method_type")
+	notificationRulePhoneChannels = enumValues("OnCallNotificationRulePhoneDetailsPublicV2", "channel")
+	notificationRuleCriticalities = enumValues("OnCallNotificationRuleAppDetailsPublicV2", "push_notification_criticality")
+)
+
+type IncidentUserNotificationRuleResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentUserNotificationRuleResourceModel struct {
+	ID                          types.String   `tfsdk:"id"`
+	UserID                      types.String   `tfsdk:"user_id"`
+	RuleType                    types.String   `tfsdk:"rule_type"`
+	MethodType                  types.String   `tfsdk:"method_type"`
+	MethodID                    types.String   `tfsdk:"method_id"`
+	DelaySeconds                types.Int64    `tfsdk:"delay_seconds"`
+	PhoneChannel                types.String   `tfsdk:"phone_channel"`
+	PushNotificationCriticality types.String   `tfsdk:"push_notification_criticality"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentUserNotificationRuleResource() resource.Resource {
+	return &IncidentUserNotificationRuleResource{}
+}
+
+func (r *IncidentUserNotificationRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_notification_rule"
+}
+
+func (r *IncidentUserNotificationRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.String{
+		stringplanmodifier.RequiresReplace(),
+	}
+	// The API fills in the phone and app details for rules of that type when they're
+	// left out, so an unset value keeps whatever it chose rather than forcing a new rule.
+	computedRequiresReplace := []planmodifier.String{
+		stringplanmodifier.UseStateForUnknown(),
+		stringplanmodifier.RequiresReplace(),
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage one of a user's notification rules: how, and how soon, they're notified when
+they're paged. Give a user several rules to build up an escalating sequence, such as a push
+notification straight away and a phone call two minutes later.
+
+The API can't change or remove a notification rule once it's created, so changing any
+attribute creates a new rule, and destroying this resource only removes it from state. The
+plan warns when that would leave a rule in place: remove it from the user's notification
+preferences in the dashboard or app if it's no longer wanted.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: apischema.Docstring("OnCallNotificationRulePublicV2", "id"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the rule notifies.",
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"rule_type": schema.StringAttribute{
+				MarkdownDescription: EnumValuesDescription("OnCallNotificationRuleCreatePayloadPublicV2", "rule_type"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"method_type": schema.StringAttribute{
+				MarkdownDescription: notificationMethodTypeDescription("OnCallNotificationRuleCreatePayloadPublicV2"),
+				Required:            true,
+				PlanModifiers:       requiresReplace,
+			},
+			"method_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of one of the user's notification methods to notify. Leave unset to " +
+					"notify every method of `method_type`, such as each of the user's devices.",
+				Optional:      true,
+				PlanModifiers: requiresReplace,
+			},
+			"delay_seconds": schema.Int64Attribute{
+				MarkdownDescription: apischema.Docstring("OnCallNotificationRuleCreatePayloadPublicV2", "delay_seconds"),
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"phone_channel": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("For a `phone` rule, whether to send an SMS or make a voice call. "+
+					"Possible values are: %s. The API picks one when this is unset.", backtickValues(notificationRulePhoneChannels)),
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: computedRequiresReplace,
+			},
+			"push_notification_criticality": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("For an `app` rule, whether push notifications bypass Do Not Disturb "+
+					"(`critical`) or respect it (`active`). Possible values are: %s. The API picks one when this is unset.",
+					backtickValues(notificationRuleCriticalities)),
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: computedRequiresReplace,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentUserNotificationRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+// ValidateConfig checks the enums, and that the phone and app details are only set on
+// rules of that method type. The API would reject either, but only after it's too late to
+// change the plan.
+func (r *IncidentUserNotificationRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, attr := range []struct {
+		name    string
+		value   types.String
+		allowed []string
+	}{
+		{"rule_type", data.RuleType, notificationRuleTypes},
+		{"method_type", data.MethodType, notificationRuleMethodTypes},
+		{"phone_channel", data.PhoneChannel, notificationRulePhoneChannels},
+		{"push_notification_criticality", data.PushNotificationCriticality, notificationRuleCriticalities},
+	} {
+		if value, ok := knownString(attr.value); ok && !slices.Contains(attr.allowed, value) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attr.name),
+				fmt.Sprintf("Invalid %s", attr.name),
+				fmt.Sprintf("Expected one of %v, got %q.", attr.allowed, value),
+			)
+		}
+	}
+
+	if delay, ok := knownInt64(data.DelaySeconds); ok && delay < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("delay_seconds"),
+			"Invalid delay_seconds",
+			fmt.Sprintf("The delay can't be negative, got %d. Use 0 to notify straight away.", delay),
+		)
+	}
+
+	methodType, ok := knownString(data.MethodType)
+	if !ok {
+		return
+	}
+	if _, set := knownString(data.PhoneChannel); set && methodType != string(client.OnCallNotificationRuleCreatePayloadPublicV2MethodTypePhone) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("phone_channel"),
+			"Unexpected phone_channel",
+			fmt.Sprintf("phone_channel only applies to rules with a method_type of phone, not %q.", methodType),
+		)
+	}
+	if _, set := knownString(data.PushNotificationCriticality); set && methodType != string(client.OnCallNotificationRuleCreatePayloadPublicV2MethodTypeApp) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("push_notification_criticality"),
+			"Unexpected push_notification_criticality",
+			fmt.Sprintf("push_notification_criticality only applies to rules with a method_type of app, not %q.", methodType),
+		)
+	}
+}
+
+// ModifyPlan warns when a change would leave the existing rule in place, as every
+// attribute forces replacement and the API can't remove a rule.
+func (r *IncidentUserNotificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state, plan *IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if !req.Plan.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan != nil && !notificationRuleChanged(state, plan) {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Notification rule will stay in place",
+		fmt.Sprintf("The API can't remove notification rules, so rule %s (%s by %s after %ds) will keep "+
+			"notifying user %s after this change. Remove it from their notification preferences if it's "+
+			"no longer wanted.", state.ID.ValueString(), state.RuleType.ValueString(), state.MethodType.ValueString(),
+			state.DelaySeconds.ValueInt64(), state.UserID.ValueString()),
+	)
+}
+
+// notificationRuleChanged is true when the plan changes any of the rule's configurable
+// attributes.
+func notificationRuleChanged(state, plan *IncidentUserNotificationRuleResourceModel) bool {
+	return !plan.UserID.Equal(state.UserID) ||
+		!plan.RuleType.Equal(state.RuleType) ||
+		!plan.MethodType.Equal(state.MethodType) ||
+		!plan.MethodID.Equal(state.MethodID) ||
+		!plan.DelaySeconds.Equal(state.DelaySeconds) ||
+		!plan.PhoneChannel.Equal(state.PhoneChannel) ||
+		!plan.PushNotificationCriticality.Equal(state.PushNotificationCriticality)
+}
+
+func (r *IncidentUserNotificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.UsersV2CreateNotificationRuleWithResponse(ctx, data.UserID.ValueString(), client.UsersV2CreateNotificationRuleJSONRequestBody{
+		NotificationRule: buildNotificationRulePayload(data),
+	})
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create user notification rule", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a user notification rule resource with id=%s", result.JSON201.NotificationRule.Id))
+	model := r.buildModel(data.UserID.ValueString(), result.JSON201.NotificationRule)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id", "id")
+}
+
+func (r *IncidentUserNotificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	// There's no endpoint to fetch a single rule, so find it in the user's list.
+	result, err := r.client.UsersV2ListNotificationRulesWithResponse(ctx, data.UserID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User with ID %s not found: removing notification rule %s from state.", data.UserID.ValueString(), data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read user notification rule", err)
+		return
+	}
+
+	rule, found := lo.Find(result.JSON200.NotificationRules, func(rule client.OnCallNotificationRulePublicV2) bool {
+		return rule.Id == data.ID.ValueString()
+	})
+	if !found {
+		tflog.Warn(ctx, fmt.Sprintf("User notification rule with ID %s not found: removing from state.", data.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	model := r.buildModel(data.UserID.ValueString(), rule)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id", "id")
+}
+
+// Update is never called with a change to apply, as every attribute forces replacement.
+func (r *IncidentUserNotificationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id", "id")
+}
+
+// Delete only forgets the rule: the API has no way to remove one. ModifyPlan has already
+// warned that it stays in place.
+func (r *IncidentUserNotificationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentUserNotificationRuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("User notification rule with ID %s can't be deleted through the API: removing it from state only.", data.ID.ValueString()))
+}
+
+// ImportState takes "<user_id>:<rule_id>", since rules can only be listed by user.
+func (r *IncidentUserNotificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importID(ctx, req, ":", &resp.Diagnostics, "user_id", "id")
+	userID, ruleID, found := strings.Cut(id, ":")
+	if !found || userID == "" || ruleID == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import identifier",
+			fmt.Sprintf("expected <user_id>:<rule_id>, got %q.", id),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleID)...)
+}
+
+func (r *IncidentUserNotificationRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"user_id": "The ID of the user the notification rule belongs to.",
+		"id":      "The ID of the notification rule.",
+	})
+}
+
+func buildNotificationRulePayload(data *IncidentUserNotificationRuleResourceModel) client.OnCallNotificationRuleCreatePayloadPublicV2 {
+	payload := client.OnCallNotificationRuleCreatePayloadPublicV2{
+		RuleType:     client.OnCallNotificationRuleCreatePayloadPublicV2RuleType(data.RuleType.ValueString()),
+		MethodType:   client.OnCallNotificationRuleCreatePayloadPublicV2MethodType(data.MethodType.ValueString()),
+		DelaySeconds: data.DelaySeconds.ValueInt64(),
+		MethodTarget: client.OnCallNotificationRuleMethodTargetPublicV2{
+			Type: client.OnCallNotificationRuleMethodTargetPublicV2TypeAll,
+			All:  &client.OnCallNotificationRuleMethodTargetAllPublicV2{},
+		},
+	}
+	if !data.MethodID.IsNull() {
+		payload.MethodTarget = client.OnCallNotificationRuleMethodTargetPublicV2{
+			Type:     client.OnCallNotificationRuleMethodTargetPublicV2TypeSpecific,
+			Specific: &client.OnCallNotificationRuleMethodTargetSpecificPublicV2{Id: data.MethodID.ValueString()},
+		}
+	}
+	if !data.PhoneChannel.IsNull() && !data.PhoneChannel.IsUnknown() {
+		payload.Phone = &client.OnCallNotificationRulePhoneDetailsPublicV2{
+			Channel: client.OnCallNotificationRulePhoneDetailsPublicV2Channel(data.PhoneChannel.ValueString()),
+		}
+	}
+	if !data.PushNotificationCriticality.IsNull() && !data.PushNotificationCriticality.IsUnknown() {
+		payload.App = &client.OnCallNotificationRuleAppDetailsPublicV2{
+			PushNotificationCriticality: client.OnCallNotificationRuleAppDetailsPublicV2PushNotificationCriticality(data.PushNotificationCriticality.ValueString()),
+		}
+	}
+
+	return payload
+}
+
+// buildModel converts from the response type to the terraform model/schema type. The
+// rule doesn't say which user it belongs to, so that comes from the caller.
+func (r *IncidentUserNotificationRuleResource) buildModel(userID string, rule client.OnCallNotificationRulePublicV2) *IncidentUserNotificationRuleResourceModel {
+	model := &IncidentUserNotificationRuleResourceModel{
+		ID:                          types.StringValue(rule.Id),
+		UserID:                      types.StringValue(userID),
+		RuleType:                    types.StringValue(string(rule.RuleType)),
+		MethodType:                  types.StringValue(string(rule.MethodType)),
+		MethodID:                    types.StringNull(),
+		DelaySeconds:                types.Int64Value(lo.FromPtr(rule.DelaySeconds)),
+		PhoneChannel:                types.StringNull(),
+		PushNotificationCriticality: types.StringNull(),
+	}
+	if rule.MethodTarget.Specific != nil {
+		model.MethodID = types.StringValue(rule.MethodTarget.Specific.Id)
+	}
+	if rule.Phone != nil {
+		model.PhoneChannel = types.StringValue(string(rule.Phone.Channel))
+	}
+	if rule.App != nil {
+		model.PushNotificationCriticality = types.StringValue(string(rule.App.PushNotificationCriticality))
+	}
+
+	return model
+}
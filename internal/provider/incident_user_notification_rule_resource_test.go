@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// TestAccIncidentUserNotificationRuleResource adds a notification rule to a user.
+//
+// NOTE: The API can't remove notification rules, so every run leaves one behind on the
+// user. Set TF_ACC_NOTIFICATION_RULE_USER_ID to a user set aside for it to run this.
+func TestAccIncidentUserNotificationRuleResource(t *testing.T) {
+	userID := os.Getenv("TF_ACC_NOTIFICATION_RULE_USER_ID")
+	if userID == "" {
+		t.Skip("TF_ACC_NOTIFICATION_RULE_USER_ID is not set: skipping test that leaves a notification rule behind")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read. The delay varies between runs, so the rules earlier runs
+			// left behind don't duplicate this one.
+			{
+				Config: fmt.Sprintf(`
+resource "incident_user_notification_rule" "example" {
+  user_id       = %q
+  rule_type     = "high_urgency"
+  method_type   = "app"
+  delay_seconds = %d
+}
+`, userID, 60+stableRank()%60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"incident_user_notification_rule.example", "id", regexp.MustCompile("^[a-zA-Z0-9]+$")),
+					resource.TestCheckResourceAttr("incident_user_notification_rule.example", "rule_type", "high_urgency"),
+					resource.TestCheckResourceAttr("incident_user_notification_rule.example", "method_type", "app"),
+					resource.TestCheckNoResourceAttr("incident_user_notification_rule.example", "method_id"),
+				),
+			},
+			// Ensure no drift after refresh
+			{
+				RefreshState: true,
+				PlanOnly:     true,
+				RefreshPlanChecks: resource.RefreshPlanChecks{
+					PostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			// Import
+			{
+				ResourceName:      "incident_user_notification_rule.example",
+				ImportState:       true,
+				ImportStateIdFunc: testAccUserNotificationRuleImportID("incident_user_notification_rule.example"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccUserNotificationRuleImportID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["user_id"] + ":" + rs.Primary.ID, nil
+	}
+}
+
+func TestBuildNotificationRulePayload(t *testing.T) {
+	t.Run("targets every method of the type by default", func(t *testing.T) {
+		payload := buildNotificationRulePayload(&IncidentUserNotificationRuleResourceModel{
+			RuleType:                    types.StringValue("high_urgency"),
+			MethodType:                  types.StringValue("app"),
+			MethodID:                    types.StringNull(),
+			DelaySeconds:                types.Int64Value(0),
+			PhoneChannel:                types.StringUnknown(),
+			PushNotificationCriticality: types.StringValue("critical"),
+		})
+
+		assert.Equal(t, client.OnCallNotificationRuleMethodTargetPublicV2TypeAll, payload.MethodTarget.Type)
+		assert.NotNil(t, payload.MethodTarget.All)
+		assert.Nil(t, payload.MethodTarget.Specific)
+		assert.Nil(t, payload.Phone)
+		assert.Equal(t, client.OnCallNotificationRuleAppDetailsPublicV2PushNotificationCriticalityCritical, payload.App.PushNotificationCriticality)
+	})
+
+	t.Run("targets a specific method", func(t *testing.T) {
+		payload := buildNotificationRulePayload(&IncidentUserNotificationRuleResourceModel{
+			RuleType:                    types.StringValue("high_urgency"),
+			MethodType:                  types.StringValue("phone"),
+			MethodID:                    types.StringValue("01PHONE"),
+			DelaySeconds:                types.Int64Value(120),
+			PhoneChannel:                types.StringValue("voice"),
+			PushNotificationCriticality: types.StringUnknown(),
+		})
+
+		assert.Equal(t, client.OnCallNotificationRuleMethodTargetPublicV2TypeSpecific, payload.MethodTarget.Type)
+		assert.Equal(t, "01PHONE", payload.MethodTarget.Specific.Id)
+		assert.Equal(t, int64(120), payload.DelaySeconds)
+		assert.Equal(t, client.Voice, payload.Phone.Channel)
+		assert.Nil(t, payload.App)
+	})
+}
+
+func TestNotificationRuleChanged(t *testing.T) {
+	state := &IncidentUserNotificationRuleResourceModel{
+		UserID:       types.StringValue("01USER"),
+		RuleType:     types.StringValue("high_urgency"),
+		MethodType:   types.StringValue("phone"),
+		DelaySeconds: types.Int64Value(120),
+		PhoneChannel: types.StringValue("voice"),
+	}
+
+	same := *state
+	assert.False(t, notificationRuleChanged(state, &same))
+
+	later := *state
+	later.DelaySeconds = types.Int64Value(300)
+	assert.True(t, notificationRuleChanged(state, &later))
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUserNotificationRuleValidateConfig(t *testing.T) {
+	str := func(value string) tftypes.Value { return tftypes.NewValue(tftypes.String, value) }
+
+	testCases := []struct {
+		name      string
+		overrides map[string]tftypes.Value
+		expectErr bool
+	}{
+		{
+			name: "push straight away is fine",
+		},
+		{
+			name: "phone call with a channel is fine",
+			overrides: map[string]tftypes.Value{
+				"method_type":   str("phone"),
+				"phone_channel": str("voice"),
+				"delay_seconds": tftypes.NewValue(tftypes.Number, 120),
+			},
+		},
+		{
+			name:      "unrecognised rule type is rejected",
+			overrides: map[string]tftypes.Value{"rule_type": str("urgent")},
+			expectErr: true,
+		},
+		{
+			name:      "unrecognised method type is rejected",
+			overrides: map[string]tftypes.Value{"method_type": str("pager")},
+			expectErr: true,
+		},
+		{
+			name:      "negative delay is rejected",
+			overrides: map[string]tftypes.Value{"delay_seconds": tftypes.NewValue(tftypes.Number, -1)},
+			expectErr: true,
+		},
+		{
+			name:      "phone channel on an app rule is rejected",
+			overrides: map[string]tftypes.Value{"phone_channel": str("sms")},
+			expectErr: true,
+		},
+		{
+			name: "criticality on a phone rule is rejected",
+			overrides: map[string]tftypes.Value{
+				"method_type":                   str("phone"),
+				"push_notification_criticality": str("critical"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "unknown method type is left alone",
+			overrides: map[string]tftypes.Value{
+				"method_type":   tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"phone_channel": str("sms"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := validateUserNotificationRule(t, tc.overrides)
+			if resp.Diagnostics.HasError() != tc.expectErr {
+				t.Errorf("expected error: %v, got diagnostics: %+v", tc.expectErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func validateUserNotificationRule(t *testing.T, overrides map[string]tftypes.Value) resource.ValidateConfigResponse {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+	NewIncidentUserNotificationRuleResource().Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	objType, ok := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	if !ok {
+		t.Fatalf("schema type is not an object")
+	}
+
+	attributes := map[string]tftypes.Value{
+		"id":                            tftypes.NewValue(tftypes.String, nil),
+		"user_id":                       tftypes.NewValue(tftypes.String, "01USER"),
+		"rule_type":                     tftypes.NewValue(tftypes.String, "high_urgency"),
+		"method_type":                   tftypes.NewValue(tftypes.String, "app"),
+		"method_id":                     tftypes.NewValue(tftypes.String, nil),
+		"delay_seconds":                 tftypes.NewValue(tftypes.Number, 0),
+		"phone_channel":                 tftypes.NewValue(tftypes.String, nil),
+		"push_notification_criticality": tftypes.NewValue(tftypes.String, nil),
+		"timeouts":                      tftypes.NewValue(objType.AttributeTypes["timeouts"], nil),
+	}
+	for name, value := range overrides {
+		if _, ok := attributes[name]; !ok {
+			t.Fatalf("override %q isn't an attribute on the notification rule schema", name)
+		}
+		attributes[name] = value
+	}
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(objType, attributes),
+	}
+
+	r, ok := NewIncidentUserNotificationRuleResource().(*IncidentUserNotificationRuleResource)
+	if !ok {
+		t.Fatalf("NewIncidentUserNotificationRuleResource did not return a *IncidentUserNotificationRuleResource")
+	}
+	var resp resource.ValidateConfigResponse
+	r.ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+	return resp
+}
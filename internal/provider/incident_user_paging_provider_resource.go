@@ -0,0 +1,239 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &IncidentUserPagingProviderResource{}
+	_ resource.ResourceWithConfigure      = &IncidentUserPagingProviderResource{}
+	_ resource.ResourceWithImportState    = &IncidentUserPagingProviderResource{}
+	_ resource.ResourceWithIdentity       = &IncidentUserPagingProviderResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentUserPagingProviderResource{}
+)
+
+var pagingProviders = enumValues("UsersUpdatePagingProviderPayloadV2", "preferred_escalation_provider")
+
+type IncidentUserPagingProviderResource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentUserPagingProviderResourceModel struct {
+	ID                          types.String   `tfsdk:"id"`
+	UserID                      types.String   `tfsdk:"user_id"`
+	PreferredEscalationProvider types.String   `tfsdk:"preferred_escalation_provider"`
+	Timeouts                    timeouts.Value `tfsdk:"timeouts"`
+}
+
+func NewIncidentUserPagingProviderResource() resource.Resource {
+	return &IncidentUserPagingProviderResource{}
+}
+
+func (r *IncidentUserPagingProviderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_paging_provider"
+}
+
+func (r *IncidentUserPagingProviderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage which provider pages a user when they're escalated to: incident.io itself, or
+the provider you're migrating from. Use it to move users onto incident.io paging in batches.
+
+Every user has a paging provider, so destroying this resource only removes it from state:
+the user keeps being paged by whichever provider it last set.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The ID of the user, as the paging provider is a setting of the user rather than an object of its own.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user whose paging provider this sets.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"preferred_escalation_provider": schema.StringAttribute{
+				MarkdownDescription: "Which provider pages the user when they're escalated to. Possible values are: " + backtickValues(pagingProviders) + ".",
+				Required:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
+	}
+}
+
+func (r *IncidentUserPagingProviderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+}
+
+func (r *IncidentUserPagingProviderResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var provider types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("preferred_escalation_provider"), &provider)...)
+
+	if value, ok := knownString(provider); ok && !slices.Contains(pagingProviders, value) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("preferred_escalation_provider"),
+			"Invalid paging provider",
+			fmt.Sprintf("Expected one of %v, got %q.", pagingProviders, value),
+		)
+	}
+}
+
+func (r *IncidentUserPagingProviderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentUserPagingProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	data, err := r.update(ctx, data)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to set user paging provider", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("set the paging provider for user id=%s", data.UserID.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id")
+}
+
+func (r *IncidentUserPagingProviderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentUserPagingProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.UsersV2ShowPagingProviderWithResponse(ctx, data.UserID.ValueString())
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("User with ID %s not found: removing paging provider from state.", data.UserID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read user paging provider", err)
+		return
+	}
+
+	model := r.buildModel(data.UserID.ValueString(), result.JSON200.PreferredEscalationProvider)
+	model.Timeouts = data.Timeouts
+	data = model
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id")
+}
+
+func (r *IncidentUserPagingProviderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *IncidentUserPagingProviderResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	data, err := r.update(ctx, data)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update user paging provider", err)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIdentityFromState(ctx, resp.State, resp.Identity, &resp.Diagnostics, "user_id")
+}
+
+// Delete only forgets the setting. Every user is paged by some provider, and switching
+// them back to one we can't know they want would be worse than leaving them be.
+func (r *IncidentUserPagingProviderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentUserPagingProviderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Leaving user %s paged by %s: removing the paging provider from state only.", data.UserID.ValueString(), data.PreferredEscalationProvider.ValueString()))
+}
+
+// ImportState takes the ID of the user.
+func (r *IncidentUserPagingProviderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID := importID(ctx, req, "", &resp.Diagnostics, "user_id")
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+}
+
+func (r *IncidentUserPagingProviderResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = identitySchema(map[string]string{
+		"user_id": "The ID of the user whose paging provider this is.",
+	})
+}
+
+func (r *IncidentUserPagingProviderResource) update(ctx context.Context, data *IncidentUserPagingProviderResourceModel) (*IncidentUserPagingProviderResourceModel, error) {
+	_, err := r.client.UsersV2UpdatePagingProviderWithResponse(ctx, data.UserID.ValueString(), client.UsersV2UpdatePagingProviderJSONRequestBody{
+		PreferredEscalationProvider: client.UsersUpdatePagingProviderPayloadV2PreferredEscalationProvider(data.PreferredEscalationProvider.ValueString()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The update doesn't return the user's effective provider, so read it back.
+	result, err := r.client.UsersV2ShowPagingProviderWithResponse(ctx, data.UserID.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	model := r.buildModel(data.UserID.ValueString(), result.JSON200.PreferredEscalationProvider)
+	model.Timeouts = data.Timeouts
+
+	return model, nil
+}
+
+func (r *IncidentUserPagingProviderResource) buildModel(userID string, provider *client.UsersShowPagingProviderResultV2PreferredEscalationProvider) *IncidentUserPagingProviderResourceModel {
+	model := &IncidentUserPagingProviderResourceModel{
+		ID:                          types.StringValue(userID),
+		UserID:                      types.StringValue(userID),
+		PreferredEscalationProvider: types.StringNull(),
+	}
+	if provider != nil {
+		model.PreferredEscalationProvider = types.StringValue(string(*provider))
+	}
+
+	return model
+}
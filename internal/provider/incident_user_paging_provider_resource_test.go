@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// TestAccIncidentUserPagingProviderResource switches a user between paging providers.
+//
+// NOTE: This changes who pages a real user, so it only runs against a user set aside for
+// it. Set TF_ACC_PAGING_PROVIDER_USER_ID to run it. The user is left on native paging.
+func TestAccIncidentUserPagingProviderResource(t *testing.T) {
+	userID := os.Getenv("TF_ACC_PAGING_PROVIDER_USER_ID")
+	if userID == "" {
+		t.Skip("TF_ACC_PAGING_PROVIDER_USER_ID is not set: skipping test that changes a user's paging provider")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read
+			{
+				Config: testAccUserPagingProviderResourceConfig(userID, "pagerduty"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incident_user_paging_provider.example", "id", userID),
+					resource.TestCheckResourceAttr("incident_user_paging_provider.example", "preferred_escalation_provider", "pagerduty"),
+				),
+			},
+			// Import
+			{
+				ResourceName:      "incident_user_paging_provider.example",
+				ImportState:       true,
+				ImportStateId:     userID,
+				ImportStateVerify: true,
+			},
+			// Update and read
+			{
+				Config: testAccUserPagingProviderResourceConfig(userID, "native"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("incident_user_paging_provider.example", "preferred_escalation_provider", "native"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserPagingProviderResourceConfig(userID, provider string) string {
+	return fmt.Sprintf(`
+resource "incident_user_paging_provider" "example" {
+  user_id                       = %q
+  preferred_escalation_provider = %q
+}
+`, userID, provider)
+}
+
+func TestUserPagingProviderBuildModel(t *testing.T) {
+	r := &IncidentUserPagingProviderResource{}
+
+	provider := client.UsersShowPagingProviderResultV2PreferredEscalationProviderOpsgenie
+	model := r.buildModel("01USER", &provider)
+	assert.Equal(t, "01USER", model.ID.ValueString())
+	assert.Equal(t, "01USER", model.UserID.ValueString())
+	assert.Equal(t, "opsgenie", model.PreferredEscalationProvider.ValueString())
+
+	assert.True(t, r.buildModel("01USER", nil).PreferredEscalationProvider.IsNull())
+}
@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &IncidentUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &IncidentUsersDataSource{}
+)
+
+const userListPageSize = 250
+
+func NewIncidentUsersDataSource() datasource.DataSource {
+	return &IncidentUsersDataSource{}
+}
+
+type IncidentUsersDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentUsersDataSourceModel struct {
+	Role                       types.String             `tfsdk:"role"`
+	IsActive                   types.Bool               `tfsdk:"is_active"`
+	EmailDomain                types.String             `tfsdk:"email_domain"`
+	IncludeNotificationMethods types.Bool               `tfsdk:"include_notification_methods"`
+	IncludeNotificationRules   types.Bool               `tfsdk:"include_notification_rules"`
+	Users                      []IncidentUsersUserModel `tfsdk:"users"`
+}
+
+type IncidentUsersUserModel struct {
+	ID                  types.String                           `tfsdk:"id"`
+	Name                types.String                           `tfsdk:"name"`
+	Email               types.String                           `tfsdk:"email"`
+	SlackUserID         types.String                           `tfsdk:"slack_user_id"`
+	IsActive            types.Bool                             `tfsdk:"is_active"`
+	BaseRole            types.String                           `tfsdk:"base_role"`
+	CustomRoles         []types.String                         `tfsdk:"custom_roles"`
+	NotificationMethods []IncidentUsersNotificationMethodModel `tfsdk:"notification_methods"`
+	NotificationRules   []IncidentUsersNotificationRuleModel   `tfsdk:"notification_rules"`
+}
+
+type IncidentUsersNotificationMethodModel struct {
+	ID            types.String `tfsdk:"id"`
+	MethodType    types.String `tfsdk:"method_type"`
+	Address       types.String `tfsdk:"address"`
+	IsUsable      types.Bool   `tfsdk:"is_usable"`
+	SupportsSMS   types.Bool   `tfsdk:"supports_sms"`
+	SupportsVoice types.Bool   `tfsdk:"supports_voice"`
+}
+
+type IncidentUsersNotificationRuleModel struct {
+	ID                          types.String `tfsdk:"id"`
+	RuleType                    types.String `tfsdk:"rule_type"`
+	MethodType                  types.String `tfsdk:"method_type"`
+	MethodID                    types.String `tfsdk:"method_id"`
+	DelaySeconds                types.Int64  `tfsdk:"delay_seconds"`
+	PhoneChannel                types.String `tfsdk:"phone_channel"`
+	PushNotificationCriticality types.String `tfsdk:"push_notification_criticality"`
+}
+
+func (d *IncidentUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *IncidentUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the users in your organisation, optionally narrowed down by role, active state or
+email domain.
+
+Set ` + "`include_notification_methods` or `include_notification_rules`" + ` to also read how each user is
+paged. Each costs one extra request per user, so narrow the list down first in a large
+organisation.`,
+		Attributes: map[string]schema.Attribute{
+			"role": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "Only list users with this role, given as a slug such as `administrator`. " +
+					"Matches a user's base role or any of their custom roles.",
+			},
+			"is_active": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Set to `true` to list only active users, or `false` to list only deactivated " +
+					"or not-yet-active ones. Leave unset to list both.",
+			},
+			"email_domain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list users whose email address is at this domain, such as `example.com`. Case-insensitive.",
+			},
+			"include_notification_methods": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to read each user's notification methods into `notification_methods`.",
+			},
+			"include_notification_rules": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to read each user's notification rules into `notification_rules`.",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The users that match every filter, in the order the API returns them.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("UserWithRolesV2", "id"),
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("UserWithRolesV2", "name"),
+						},
+						"email": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("UserWithRolesV2", "email"),
+						},
+						"slack_user_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("UserWithRolesV2", "slack_user_id"),
+						},
+						"is_active": schema.BoolAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("UserWithRolesV2", "is_active"),
+						},
+						"base_role": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The slug of the user's base role.",
+						},
+						"custom_roles": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							MarkdownDescription: "The slugs of the user's custom roles.",
+						},
+						"notification_methods": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user's notification methods. Null unless `include_notification_methods` is set.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("OnCallNotificationMethodPublicV2", "id"),
+									},
+									"method_type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: notificationMethodTypeDescription("OnCallNotificationMethodPublicV2"),
+									},
+									"address": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("OnCallNotificationMethodPublicV2", "address"),
+									},
+									"is_usable": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("OnCallNotificationMethodPublicV2", "is_usable"),
+									},
+									"supports_sms": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "For a phone method, whether the number can receive SMS notifications. Null for other methods.",
+									},
+									"supports_voice": schema.BoolAttribute{
+										Computed:            true,
+										MarkdownDescription: "For a phone method, whether the number can receive voice calls. Null for other methods.",
+									},
+								},
+							},
+						},
+						"notification_rules": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The user's notification rules. Null unless `include_notification_rules` is set.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("OnCallNotificationRulePublicV2", "id"),
+									},
+									"rule_type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: EnumValuesDescription("OnCallNotificationRulePublicV2", "rule_type"),
+									},
+									"method_type": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: notificationMethodTypeDescription("OnCallNotificationRulePublicV2"),
+									},
+									"method_id": schema.StringAttribute{
+										Computed: true,
+										MarkdownDescription: "The ID of the notification method the rule uses. Null when the rule " +
+											"uses every method of its `method_type`.",
+									},
+									"delay_seconds": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("OnCallNotificationRulePublicV2", "delay_seconds"),
+									},
+									"phone_channel": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "For a phone rule, whether it sends an `sms` or a `voice` call. Null for other rules.",
+									},
+									"push_notification_criticality": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "For an app rule, whether push notifications are `critical` and bypass Do Not Disturb, or `active` and respect it. Null for other rules.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// notificationMethodTypeDescription documents a method_type, whose API docstring is
+// written for a payload and already ends in a full stop.
+func notificationMethodTypeDescription(definitionName string) string {
+	return fmt.Sprintf("How the user is notified. Possible values are: %s.", backtickValues(enumValues(definitionName, "method_type")))
+}
+
+func (d *IncidentUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+func (d *IncidentUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API hides inactive users unless asked, so only leave them out when that's the
+	// filter we want anyway.
+	users, err := listUsers(ctx, d.client, !data.IsActive.ValueBool())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list users", err)
+		return
+	}
+
+	data.Users = []IncidentUsersUserModel{}
+	for _, user := range filterUsers(users, data.Role, data.IsActive, data.EmailDomain) {
+		model := buildUsersUserModel(user)
+
+		if data.IncludeNotificationMethods.ValueBool() {
+			result, err := d.client.UsersV2ListNotificationMethodsWithResponse(ctx, user.Id)
+			if err != nil {
+				addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to list notification methods for user %s (%s)", user.Name, user.Id), err)
+				return
+			}
+			model.NotificationMethods = buildUsersNotificationMethodModels(result.JSON200.NotificationMethods)
+		}
+
+		if data.IncludeNotificationRules.ValueBool() {
+			result, err := d.client.UsersV2ListNotificationRulesWithResponse(ctx, user.Id)
+			if err != nil {
+				addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", fmt.Sprintf("Unable to list notification rules for user %s (%s)", user.Name, user.Id), err)
+				return
+			}
+			model.NotificationRules = buildUsersNotificationRuleModels(result.JSON200.NotificationRules)
+		}
+
+		data.Users = append(data.Users, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listUsers returns every user in the organisation, following the pagination cursor
+// until the last page.
+func listUsers(ctx context.Context, apiClient *client.ClientWithResponses, includeInactive bool) ([]client.UserWithRolesV2, error) {
+	users := []client.UserWithRolesV2{}
+	params := &client.UsersV2ListParams{
+		PageSize:        lo.ToPtr(int64(userListPageSize)),
+		IncludeInactive: lo.ToPtr(includeInactive),
+	}
+
+	for {
+		result, err := apiClient.UsersV2ListWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", result.Status())
+		}
+
+		users = append(users, result.JSON200.Users...)
+
+		if result.JSON200.PaginationMeta.After == nil {
+			break
+		}
+		params.After = result.JSON200.PaginationMeta.After
+	}
+
+	return users, nil
+}
+
+// filterUsers returns the users that match every filter that's set.
+func filterUsers(users []client.UserWithRolesV2, role types.String, isActive types.Bool, emailDomain types.String) []client.UserWithRolesV2 {
+	domain := strings.ToLower(strings.TrimPrefix(emailDomain.ValueString(), "@"))
+
+	return lo.Filter(users, func(user client.UserWithRolesV2, _ int) bool {
+		if !isActive.IsNull() && user.IsActive != isActive.ValueBool() {
+			return false
+		}
+		if !role.IsNull() && !userHasRole(user, role.ValueString()) {
+			return false
+		}
+		if !emailDomain.IsNull() {
+			_, userDomain, found := strings.Cut(lo.FromPtr(user.Email), "@")
+			if !found || strings.ToLower(userDomain) != domain {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// userHasRole is true when the user's base role or any of their custom roles has the
+// given slug.
+func userHasRole(user client.UserWithRolesV2, slug string) bool {
+	if user.BaseRole.Slug == slug {
+		return true
+	}
+
+	return lo.SomeBy(user.CustomRoles, func(role client.RBACRoleV2) bool {
+		return role.Slug == slug
+	})
+}
+
+func buildUsersUserModel(user client.UserWithRolesV2) IncidentUsersUserModel {
+	return IncidentUsersUserModel{
+		ID:          types.StringValue(user.Id),
+		Name:        types.StringValue(user.Name),
+		Email:       types.StringPointerValue(user.Email),
+		SlackUserID: types.StringPointerValue(user.SlackUserId),
+		IsActive:    types.BoolValue(user.IsActive),
+		BaseRole:    types.StringValue(user.BaseRole.Slug),
+		CustomRoles: lo.Map(user.CustomRoles, func(role client.RBACRoleV2, _ int) types.String {
+			return types.StringValue(role.Slug)
+		}),
+	}
+}
+
+func buildUsersNotificationMethodModels(methods []client.OnCallNotificationMethodPublicV2) []IncidentUsersNotificationMethodModel {
+	return lo.Map(methods, func(method client.OnCallNotificationMethodPublicV2, _ int) IncidentUsersNotificationMethodModel {
+		model := IncidentUsersNotificationMethodModel{
+			ID:            types.StringValue(method.Id),
+			MethodType:    types.StringValue(string(method.MethodType)),
+			Address:       types.StringValue(method.Address),
+			IsUsable:      types.BoolValue(method.IsUsable),
+			SupportsSMS:   types.BoolNull(),
+			SupportsVoice: types.BoolNull(),
+		}
+		if method.PhoneDetails != nil {
+			model.SupportsSMS = types.BoolValue(method.PhoneDetails.SupportsSms)
+			model.SupportsVoice = types.BoolValue(method.PhoneDetails.SupportsVoice)
+		}
+
+		return model
+	})
+}
+
+func buildUsersNotificationRuleModels(rules []client.OnCallNotificationRulePublicV2) []IncidentUsersNotificationRuleModel {
+	return lo.Map(rules, func(rule client.OnCallNotificationRulePublicV2, _ int) IncidentUsersNotificationRuleModel {
+		model := IncidentUsersNotificationRuleModel{
+			ID:                          types.StringValue(rule.Id),
+			RuleType:                    types.StringValue(string(rule.RuleType)),
+			MethodType:                  types.StringValue(string(rule.MethodType)),
+			MethodID:                    types.StringNull(),
+			DelaySeconds:                types.Int64PointerValue(rule.DelaySeconds),
+			PhoneChannel:                types.StringNull(),
+			PushNotificationCriticality: types.StringNull(),
+		}
+		if rule.MethodTarget.Specific != nil {
+			model.MethodID = types.StringValue(rule.MethodTarget.Specific.Id)
+		}
+		if rule.Phone != nil {
+			model.PhoneChannel = types.StringValue(string(rule.Phone.Channel))
+		}
+		if rule.App != nil {
+			model.PushNotificationCriticality = types.StringValue(string(rule.App.PushNotificationCriticality))
+		}
+
+		return model
+	})
+}
@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentUsersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_users" "active" {
+  is_active = true
+}
+
+data "incident_users" "with_methods" {
+  is_active                    = true
+  email_domain                 = split("@", data.incident_users.active.users[0].email)[1]
+  include_notification_methods = true
+  include_notification_rules   = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckUsersListed("data.incident_users.active"),
+					resource.TestCheckResourceAttr("data.incident_users.active", "users.0.is_active", "true"),
+					resource.TestCheckResourceAttrSet("data.incident_users.active", "users.0.base_role"),
+					resource.TestCheckNoResourceAttr("data.incident_users.active", "users.0.notification_methods"),
+					testAccCheckUsersListed("data.incident_users.with_methods"),
+					resource.TestCheckResourceAttrSet("data.incident_users.with_methods", "users.0.notification_methods.#"),
+					resource.TestCheckResourceAttrSet("data.incident_users.with_methods", "users.0.notification_rules.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsersListed(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		count, err := strconv.Atoi(rs.Primary.Attributes["users.#"])
+		if err != nil || count == 0 {
+			return fmt.Errorf("expected at least one user, got %q", rs.Primary.Attributes["users.#"])
+		}
+
+		return nil
+	}
+}
+
+func TestFilterUsers(t *testing.T) {
+	users := []client.UserWithRolesV2{
+		{
+			Id:          "01ADMIN",
+			Email:       lo.ToPtr("admin@Example.com"),
+			IsActive:    true,
+			BaseRole:    client.RBACRoleV2{Slug: "administrator"},
+			CustomRoles: []client.RBACRoleV2{{Slug: "on-call-lead"}},
+		},
+		{
+			Id:       "01RESPONDER",
+			Email:    lo.ToPtr("responder@example.com"),
+			IsActive: true,
+			BaseRole: client.RBACRoleV2{Slug: "responder"},
+		},
+		{
+			Id:       "01CONTRACTOR",
+			Email:    lo.ToPtr("contractor@agency.example.com"),
+			IsActive: true,
+			BaseRole: client.RBACRoleV2{Slug: "responder"},
+		},
+		{
+			Id:       "01LEAVER",
+			Email:    lo.ToPtr("leaver@example.com"),
+			IsActive: false,
+			BaseRole: client.RBACRoleV2{Slug: "responder"},
+		},
+		{
+			Id:       "01NOEMAIL",
+			IsActive: true,
+			BaseRole: client.RBACRoleV2{Slug: "viewer"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		role        types.String
+		isActive    types.Bool
+		emailDomain types.String
+		expected    []string
+	}{
+		{
+			name:     "no filters lists everyone",
+			expected: []string{"01ADMIN", "01RESPONDER", "01CONTRACTOR", "01LEAVER", "01NOEMAIL"},
+		},
+		{
+			name:     "active only",
+			isActive: types.BoolValue(true),
+			expected: []string{"01ADMIN", "01RESPONDER", "01CONTRACTOR", "01NOEMAIL"},
+		},
+		{
+			name:     "inactive only",
+			isActive: types.BoolValue(false),
+			expected: []string{"01LEAVER"},
+		},
+		{
+			name:     "base role",
+			role:     types.StringValue("responder"),
+			expected: []string{"01RESPONDER", "01CONTRACTOR", "01LEAVER"},
+		},
+		{
+			name:     "custom role",
+			role:     types.StringValue("on-call-lead"),
+			expected: []string{"01ADMIN"},
+		},
+		{
+			name:        "email domain is exact and case-insensitive",
+			emailDomain: types.StringValue("EXAMPLE.com"),
+			expected:    []string{"01ADMIN", "01RESPONDER", "01LEAVER"},
+		},
+		{
+			name:        "email domain with a leading @",
+			emailDomain: types.StringValue("@agency.example.com"),
+			expected:    []string{"01CONTRACTOR"},
+		},
+		{
+			name:        "filters combine",
+			role:        types.StringValue("responder"),
+			isActive:    types.BoolValue(true),
+			emailDomain: types.StringValue("example.com"),
+			expected:    []string{"01RESPONDER"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := filterUsers(users, tc.role, tc.isActive, tc.emailDomain)
+			assert.Equal(t, tc.expected, lo.Map(result, func(user client.UserWithRolesV2, _ int) string {
+				return user.Id
+			}))
+		})
+	}
+}
+
+func TestBuildUsersNotificationModels(t *testing.T) {
+	methods := buildUsersNotificationMethodModels([]client.OnCallNotificationMethodPublicV2{
+		{
+			Id:           "01PHONE",
+			MethodType:   client.OnCallNotificationMethodPublicV2MethodTypePhone,
+			Address:      "•••••••6789",
+			IsUsable:     true,
+			PhoneDetails: &client.OnCallNotificationMethodPhoneDetailsPublicV2{SupportsSms: true, SupportsVoice: false},
+		},
+		{
+			Id:         "01EMAIL",
+			MethodType: client.OnCallNotificationMethodPublicV2MethodTypeEmail,
+			Address:    "lisa@example.com",
+			IsUsable:   true,
+		},
+	})
+
+	assert.Equal(t, "phone", methods[0].MethodType.ValueString())
+	assert.True(t, methods[0].SupportsSMS.ValueBool())
+	assert.False(t, methods[0].SupportsVoice.ValueBool())
+	assert.True(t, methods[1].SupportsSMS.IsNull())
+	assert.True(t, methods[1].SupportsVoice.IsNull())
+
+	rules := buildUsersNotificationRuleModels([]client.OnCallNotificationRulePublicV2{
+		{
+			Id:           "01RULE",
+			RuleType:     client.OnCallNotificationRulePublicV2RuleTypeHighUrgency,
+			MethodType:   client.OnCallNotificationRulePublicV2MethodType("phone"),
+			DelaySeconds: lo.ToPtr(int64(60)),
+			MethodTarget: client.OnCallNotificationRuleMethodTargetPublicV2{
+				Type:     client.OnCallNotificationRuleMethodTargetPublicV2TypeSpecific,
+				Specific: &client.OnCallNotificationRuleMethodTargetSpecificPublicV2{Id: "01PHONE"},
+			},
+			Phone: &client.OnCallNotificationRulePhoneDetailsPublicV2{Channel: "voice"},
+		},
+		{
+			Id:           "01ALLAPPS",
+			RuleType:     client.OnCallNotificationRulePublicV2RuleTypeLowUrgency,
+			MethodType:   client.OnCallNotificationRulePublicV2MethodType("app"),
+			MethodTarget: client.OnCallNotificationRuleMethodTargetPublicV2{Type: client.OnCallNotificationRuleMethodTargetPublicV2TypeAll},
+			App:          &client.OnCallNotificationRuleAppDetailsPublicV2{PushNotificationCriticality: "critical"},
+		},
+	})
+
+	assert.Equal(t, "01PHONE", rules[0].MethodID.ValueString())
+	assert.Equal(t, int64(60), rules[0].DelaySeconds.ValueInt64())
+	assert.Equal(t, "voice", rules[0].PhoneChannel.ValueString())
+	assert.True(t, rules[0].PushNotificationCriticality.IsNull())
+	assert.True(t, rules[1].MethodID.IsNull())
+	assert.True(t, rules[1].DelaySeconds.IsNull())
+	assert.True(t, rules[1].PhoneChannel.IsNull())
+	assert.Equal(t, "critical", rules[1].PushNotificationCriticality.ValueString())
+}
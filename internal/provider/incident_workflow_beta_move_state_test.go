@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/jsontypes"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/richtexttypes"
+)
+
+func v2Literal(literal string) *models.IncidentEngineParamBindingValue {
+	return &models.IncidentEngineParamBindingValue{
+		Literal:   jsontypes.NewNormalizedJSONOrStringValue(literal),
+		Reference: types.StringNull(),
+	}
+}
+
+// v2WorkflowState is an incident_workflow as the V2 schema stores it, holding one of each
+// shape a step param can take and a rich-text document.
+func v2WorkflowState() *IncidentWorkflowResourceModel {
+	message := `{"content":[{"content":[{"attrs":{"label":"Incident name","name":"incident.name"},"type":"varSpec"},{"text":" needs you","type":"text"}],"type":"paragraph"}],"type":"doc"}`
+
+	return &IncidentWorkflowResourceModel{
+		ID:        types.StringValue("01HXVEA7Y0VWQBJB4F2X8WNRW0"),
+		Name:      types.StringValue("Page the on-call"),
+		Folder:    types.StringNull(),
+		Shortform: types.StringNull(),
+		Trigger:   types.StringValue("incident.updated"),
+		ConditionGroups: models.IncidentEngineConditionGroups{{
+			Conditions: models.IncidentEngineConditions{{
+				Subject:   types.StringValue("incident.status.category"),
+				Operation: types.StringValue("one_of"),
+				ParamBindings: models.IncidentEngineParamBindings{{
+					ArrayValue: []models.IncidentEngineParamBindingValue{*v2Literal("open")},
+				}},
+			}},
+		}},
+		Steps: []IncidentWorkflowStep{{
+			ID:      types.StringValue("01HXVEA7Y0VWQBJB4F2X8WNRW6"),
+			Name:    types.StringValue("slack.post_message"),
+			ForEach: types.StringNull(),
+			ParamBindings: models.IncidentEngineParamBindings{
+				{Value: &models.IncidentEngineParamBindingValue{
+					Literal:   jsontypes.NewNormalizedJSONOrStringNull(),
+					Reference: types.StringValue("expressions[\"participants_cnt\"]"),
+				}},
+				{Value: v2Literal(message)},
+				{},
+				{ArrayValue: []models.IncidentEngineParamBindingValue{*v2Literal("Write postmortem")}},
+			},
+		}},
+		Expressions: models.IncidentEngineExpressions{{
+			Label:         types.StringValue("Count active participants"),
+			Reference:     types.StringValue("participants_cnt"),
+			RootReference: types.StringValue("incident.active_participants"),
+			Operations: models.IncidentEngineExpressionOperations{{
+				OperationType: types.StringValue("count"),
+			}},
+		}},
+		OnceFor:                   []types.String{types.StringValue("incident")},
+		IncludePrivateIncidents:   types.BoolValue(false),
+		PrivateIncidentScope:      types.StringValue("none"),
+		IncludePrivateEscalations: types.BoolValue(false),
+		OwningTeamIDs:             types.SetNull(types.StringType),
+		ContinueOnStepError:       types.BoolValue(false),
+		RunsOnIncidents:           types.StringValue("newly_created"),
+		RunsOnIncidentModes:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("standard")}),
+		State:                     types.StringValue("draft"),
+	}
+}
+
+func TestWorkflowBetaMoveSpellsStateInV3(t *testing.T) {
+	ctx := context.Background()
+	v2 := v2WorkflowState()
+
+	moved := workflowBetaFromV2State(ctx, v2)
+
+	if moved.ID != v2.ID || moved.Name != v2.Name || moved.PrivateIncidentScope != v2.PrivateIncidentScope {
+		t.Errorf("workflow settings weren't carried over: got %#v", moved)
+	}
+
+	if len(moved.Steps) != 1 || len(moved.Steps[0].ParamBindings) != 4 {
+		t.Fatalf("expected one step with four params, got %#v", moved.Steps)
+	}
+	if moved.Steps[0].ParamBindings[1].RichText.IsNull() {
+		t.Errorf("expected the message to read as rich_text, got %#v", moved.Steps[0].ParamBindings[1])
+	}
+	if len(moved.NamedExpressions) != 1 || moved.NamedExpressions[0].Name.ValueString() != "participants_cnt" {
+		t.Errorf("expected the expression to keep its reference as its name, got %#v", moved.NamedExpressions)
+	}
+}
+
+func workflowBetaMustPayload(t *testing.T, ctx context.Context, data *IncidentWorkflowBetaModel) workflowBetaPayloads {
+	t.Helper()
+
+	var diags diag.Diagnostics
+	payloads := (&IncidentWorkflowBetaResource{}).toPayloads(ctx, data, &diags)
+	if diags.HasError() {
+		t.Fatalf("building the payloads: %+v", diags)
+	}
+
+	return payloads
+}
+
+// TestWorkflowBetaMoveWritesTheSameWorkflow is what makes a `moved` block safe: the converted
+// state must write the workflow the V2 state described, or the first apply after the move
+// would change it.
+func TestWorkflowBetaMoveWritesTheSameWorkflow(t *testing.T) {
+	ctx := context.Background()
+	v2 := v2WorkflowState()
+
+	moved := workflowBetaFromV2State(ctx, v2)
+	payloads := workflowBetaMustPayload(t, ctx, &moved)
+
+	// The message goes out as the document its template compiles to, which leaves out the
+	// varSpec labels the dashboard rewrites on every load: equal in meaning, not in bytes.
+	gotMessage := payloads.steps[0].ParamBindings[1].Value.Literal
+	wantMessage := v2.Steps[0].ParamBindings[1].Value.Literal.ValueString()
+	equal, _ := richtexttypes.NewTemplatedTextFromLiteral(wantMessage).StringSemanticEquals(ctx, richtexttypes.NewTemplatedTextFromLiteral(*gotMessage))
+	if !equal {
+		t.Errorf("message changed meaning: got %s, want %s", *gotMessage, wantMessage)
+	}
+	payloads.steps[0].ParamBindings[1].Value.Literal = &wantMessage
+
+	steps := []client.StepConfigPayloadV2{}
+	for _, step := range v2.Steps {
+		steps = append(steps, client.StepConfigPayloadV2{
+			Id:            step.ID.ValueString(),
+			Name:          step.Name.ValueString(),
+			ParamBindings: step.ParamBindings.ToPayload(),
+		})
+	}
+
+	for _, tc := range []struct {
+		name      string
+		got, want any
+	}{
+		{"condition groups", payloads.conditionGroups, v2.ConditionGroups.ToPayload()},
+		{"expressions", payloads.expressions, v2.Expressions.ToPayload()},
+		{"steps", payloads.steps, steps},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Compare as JSON with empty array_values pruned, which is all the API sees.
+			got, want := toV3Payload[any](ctx, tc.got), toV3Payload[any](ctx, tc.want)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %#v, want %#v", got, want)
+			}
+		})
+	}
+}
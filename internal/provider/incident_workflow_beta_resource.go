@@ -0,0 +1,784 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+)
+
+var (
+	_ resource.Resource                   = &IncidentWorkflowBetaResource{}
+	_ resource.ResourceWithConfigure      = &IncidentWorkflowBetaResource{}
+	_ resource.ResourceWithImportState    = &IncidentWorkflowBetaResource{}
+	_ resource.ResourceWithIdentity       = &IncidentWorkflowBetaResource{}
+	_ resource.ResourceWithValidateConfig = &IncidentWorkflowBetaResource{}
+	_ resource.ResourceWithMoveState      = &IncidentWorkflowBetaResource{}
+)
+
+// workflowExpressions stores names as written: a workflow's expressions belong to it alone.
+var workflowExpressions = models.ExpressionNamespace{}
+
+type IncidentWorkflowBetaResource struct {
+	client           *client.ClientWithResponses
+	terraformVersion string
+}
+
+func NewIncidentWorkflowBetaResource() resource.Resource {
+	return &IncidentWorkflowBetaResource{}
+}
+
+// IncidentWorkflowBetaModel drops include_private_incidents, which incident_workflow only
+// keeps for compatibility: private_incident_scope says the same and more.
+type IncidentWorkflowBetaModel struct {
+	ID                        types.String               `tfsdk:"id"`
+	Name                      types.String               `tfsdk:"name"`
+	Folder                    types.String               `tfsdk:"folder"`
+	Shortform                 types.String               `tfsdk:"shortform"`
+	Trigger                   types.String               `tfsdk:"trigger"`
+	Conditions                []models.Condition         `tfsdk:"conditions"`
+	ConditionGroups           []models.ConditionGroup    `tfsdk:"condition_groups"`
+	Steps                     []IncidentWorkflowBetaStep `tfsdk:"step"`
+	NamedExpressions          []models.NamedExpression   `tfsdk:"named_expression"`
+	OnceFor                   []types.String             `tfsdk:"once_for"`
+	PrivateIncidentScope      types.String               `tfsdk:"private_incident_scope"`
+	IncludePrivateEscalations types.Bool                 `tfsdk:"include_private_escalations"`
+	OwningTeamIDs             types.Set                  `tfsdk:"owning_team_ids"`
+	ContinueOnStepError       types.Bool                 `tfsdk:"continue_on_step_error"`
+	Delay                     *IncidentWorkflowDelay     `tfsdk:"delay"`
+	RunsOnIncidents           types.String               `tfsdk:"runs_on_incidents"`
+	RunsOnIncidentModes       types.Set                  `tfsdk:"runs_on_incident_modes"`
+	State                     types.String               `tfsdk:"state"`
+	Timeouts                  timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type IncidentWorkflowBetaStep struct {
+	ID            types.String              `tfsdk:"id"`
+	Name          types.String              `tfsdk:"name"`
+	ForEach       types.String              `tfsdk:"for_each"`
+	ParamBindings []models.StepParamBinding `tfsdk:"param_bindings"`
+}
+
+func (r *IncidentWorkflowBetaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_beta"
+}
+
+func (r *IncidentWorkflowBetaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "id"),
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "name"),
+			Required:            true,
+		},
+		"folder": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "folder"),
+			Optional:            true,
+		},
+		"shortform": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "shortform"),
+			Optional:            true,
+		},
+		"trigger": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("TriggerSlimV2", "name"),
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"once_for": schema.ListAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "once_for"),
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+		"private_incident_scope": schema.StringAttribute{
+			MarkdownDescription: EnumValuesDescription("WorkflowV2", "private_incident_scope"),
+			Optional:            true,
+			Computed:            true,
+		},
+		"include_private_escalations": schema.BoolAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "include_private_escalations"),
+			Optional:            true,
+			Computed:            true,
+		},
+		"owning_team_ids": schema.SetAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "owning_team_ids"),
+			Optional:            true,
+			ElementType:         types.StringType,
+		},
+		"continue_on_step_error": schema.BoolAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "continue_on_step_error"),
+			Required:            true,
+		},
+		"delay": schema.SingleNestedAttribute{
+			MarkdownDescription: "Configuration controlling workflow delay behaviour",
+			Optional:            true,
+			Attributes: map[string]schema.Attribute{
+				"conditions_apply_over_delay": schema.BoolAttribute{
+					MarkdownDescription: apischema.Docstring("WorkflowDelayV2", "conditions_apply_over_delay"),
+					Required:            true,
+				},
+				"for_seconds": schema.Int64Attribute{
+					MarkdownDescription: apischema.Docstring("WorkflowDelayV2", "for_seconds"),
+					Required:            true,
+				},
+			},
+		},
+		"runs_on_incidents": schema.StringAttribute{
+			MarkdownDescription: EnumValuesDescription("WorkflowV2", "runs_on_incidents"),
+			Required:            true,
+		},
+		"runs_on_incident_modes": schema.SetAttribute{
+			MarkdownDescription: apischema.Docstring("WorkflowV2", "runs_on_incident_modes"),
+			Required:            true,
+			ElementType:         types.StringType,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: EnumValuesDescription("WorkflowV2", "state"),
+			Required:            true,
+		},
+	}
+
+	// The trigger's conditions, spelled the way they are inside an expression's filter.
+	for name, attribute := range models.ConditionsAttributes() {
+		attributes[name] = attribute
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manage a workflow, writing its conditions, expressions and step params in the same
+structured form as ` + "`incident_alert_source_beta`" + `, rather than the raw engine objects
+` + "`incident_workflow`" + ` takes.
+
+## How this differs from ` + "`incident_workflow`" + `
+
+` + "`incident_workflow`" + ` takes expressions as a set of engine objects, and every rich-text
+param — a Slack message, an incident update — as a ` + "`jsonencode`" + `'d document. Here:
+
+- each expression is a ` + "`named_expression`" + ` block, with the same operations, branches and
+  fallbacks as an alert source's;
+- conditions take ` + "`conditions`" + ` for the usual single group, and ` + "`condition_groups`" + `
+  when you need OR;
+- step params take ` + "`value_literal`" + `, ` + "`expression_ref`" + ` and the other binding
+  spellings, and ` + "`rich_text`" + ` takes a template such as
+  ` + "`\"{{ incident.name }} needs you\"`" + `.
+
+Steps still take their params positionally, as the API does. Set a param to ` + "`{}`" + ` to
+skip an optional one.
+
+Cast and concatenate operations aren't supported yet: the workflows API doesn't return them,
+so a workflow using one would show a change on every plan.
+
+## Beta, and what happens next
+
+This resource is in beta. Its schema may still change in ways that are not backwards
+compatible, so pin the provider version if that matters to you.
+
+` + "`incident_workflow`" + ` is not deprecated, and there is no need to move anything yet.
+
+## Migrating from ` + "`incident_workflow`" + `
+
+Rewrite the resource in this schema, and add a ` + "`moved`" + ` block rather than importing:
+
+` + "```terraform" + `
+moved {
+  from = incident_workflow.page_on_call
+  to   = incident_workflow_beta.page_on_call
+}
+` + "```" + `
+
+Terraform converts the existing state, so the workflow is neither recreated nor rewritten,
+and the next plan shows only where your rewrite means something different. Moving between
+resource types needs Terraform 1.8 or later.`,
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+			"step": schema.ListNestedBlock{
+				MarkdownDescription: apischema.Docstring("WorkflowV2", "steps"),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("StepConfigPayloadV2", "id"),
+							Required:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: apischema.Docstring("StepConfigPayloadV2", "name"),
+							Required:            true,
+						},
+						"for_each": schema.StringAttribute{
+							MarkdownDescription: "The name of a `named_expression` returning the things to run this step once for each of.",
+							Optional:            true,
+						},
+						"param_bindings": schema.ListNestedAttribute{
+							MarkdownDescription: "The step's params, in the order the step takes them. Set one to `{}` to skip it.",
+							Optional:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: models.StepParamBindingAttributes(),
+							},
+						},
+					},
+				},
+			},
+			"named_expression": models.NamedExpressionBlock(),
+		},
+	}
+}
+
+func (r *IncidentWorkflowBetaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client.Client
+	r.terraformVersion = client.TerraformVersion
+}
+
+// ValidateConfig runs the same expression and binding checks as the other V3 resources, so a
+// mistake lands at plan time against a path in the config rather than as an API rejection.
+func (r *IncidentWorkflowBetaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data IncidentWorkflowBetaModel
+
+	// Values computed by another resource can't be decoded into the model's concrete types,
+	// so give up on validating rather than reporting a spurious error. Terraform validates
+	// again once they're known.
+	if req.Config.Get(ctx, &data).HasError() {
+		return
+	}
+
+	if scope, ok := knownString(data.PrivateIncidentScope); ok && !lo.Contains(privateIncidentScopes, scope) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("private_incident_scope"),
+			"Invalid private_incident_scope",
+			fmt.Sprintf("private_incident_scope must be one of %v, got %q.", privateIncidentScopes, scope),
+		)
+	}
+
+	models.ValidateExpressions(
+		workflowExpressions, nil, path.Empty(),
+		data.NamedExpressions, path.Root("named_expression"), &resp.Diagnostics)
+
+	for idx, expression := range data.NamedExpressions {
+		for opIdx, operation := range expression.Operations {
+			if operation.Cast == nil && operation.Concatenate == nil {
+				continue
+			}
+
+			resp.Diagnostics.AddAttributeError(
+				path.Root("named_expression").AtListIndex(idx).AtName("operation").AtListIndex(opIdx),
+				"Unsupported operation",
+				"Workflows don't support cast or concatenate operations yet: the workflows API doesn't return them, so this workflow would show a change on every plan.",
+			)
+		}
+	}
+
+	known := models.KnownExpressionNames(data.NamedExpressions)
+	models.ValidateConditions(data.Conditions, data.ConditionGroups, path.Empty(), known, &resp.Diagnostics)
+
+	seen := map[string]bool{}
+	for idx, step := range data.Steps {
+		at := path.Root("step").AtListIndex(idx)
+
+		validateWorkflowStepID(step.ID, at.AtName("id"), seen, &resp.Diagnostics)
+
+		if forEach, ok := knownString(step.ForEach); ok && !known[forEach] {
+			resp.Diagnostics.AddAttributeError(
+				at.AtName("for_each"),
+				"Unknown for_each",
+				fmt.Sprintf("No named_expression in this workflow is called %q.", forEach),
+			)
+		}
+
+		for paramIdx, param := range step.ParamBindings {
+			models.ValidateStepParamBinding(param, at.AtName("param_bindings").AtListIndex(paramIdx), known, &resp.Diagnostics)
+		}
+	}
+}
+
+func (r *IncidentWorkflowBetaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *IncidentWorkflowBetaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
+	payloads := r.toPayloads(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runsOnIncidentModes := []client.WorkflowsCreateWorkflowPayloadV2RunsOnIncidentModes{}
+	for _, v := range data.RunsOnIncidentModes.Elements() {
+		if str, ok := v.(types.String); ok {
+			runsOnIncidentModes = append(runsOnIncidentModes, client.WorkflowsCreateWorkflowPayloadV2RunsOnIncidentModes(str.ValueString()))
+		}
+	}
+
+	payload := client.WorkflowsCreateWorkflowPayloadV2{
+		Trigger:             data.Trigger.ValueString(),
+		Name:                data.Name.ValueString(),
+		OnceFor:             workflowBetaOnceFor(data.OnceFor),
+		ConditionGroups:     payloads.conditionGroups,
+		Steps:               payloads.steps,
+		Expressions:         payloads.expressions,
+		RunsOnIncidents:     client.WorkflowsCreateWorkflowPayloadV2RunsOnIncidents(data.RunsOnIncidents.ValueString()),
+		RunsOnIncidentModes: runsOnIncidentModes,
+		Folder:              data.Folder.ValueStringPointer(),
+		Shortform:           data.Shortform.ValueStringPointer(),
+		OwningTeamIds:       toOwningTeamIDs(data.OwningTeamIDs),
+		ContinueOnStepError: data.ContinueOnStepError.ValueBool(),
+		State:               lo.ToPtr(client.WorkflowsCreateWorkflowPayloadV2State(data.State.ValueString())),
+		Annotations: &map[string]string{
+			"incident.io/terraform/version": r.terraformVersion,
+		},
+	}
+	if scope, ok := knownString(data.PrivateIncidentScope); ok {
+		payload.PrivateIncidentScope = lo.ToPtr(client.WorkflowsCreateWorkflowPayloadV2PrivateIncidentScope(scope))
+	}
+	if !data.IncludePrivateEscalations.IsNull() && !data.IncludePrivateEscalations.IsUnknown() {
+		payload.IncludePrivateEscalations = lo.ToPtr(data.IncludePrivateEscalations.ValueBool())
+	}
+	if data.Delay != nil {
+		payload.Delay = &client.WorkflowDelayV2{
+			ConditionsApplyOverDelay: data.Delay.ConditionsApplyOverDelay.ValueBool(),
+			ForSeconds:               data.Delay.ForSeconds.ValueInt64(),
+		}
+	}
+
+	result, err := r.client.WorkflowsV2CreateWorkflowWithResponse(ctx, payload)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create workflow", err)
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a workflow resource with id=%s", result.JSON201.Workflow.Id))
+	data = workflowBetaFromAPI(ctx, result.JSON201.Workflow, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentWorkflowBetaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *IncidentWorkflowBetaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
+	result, err := r.client.WorkflowsV2ShowWorkflowWithResponse(ctx, data.ID.ValueString(), &client.WorkflowsV2ShowWorkflowParams{
+		SkipStepUpgrades: lo.ToPtr(true),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			tflog.Warn(ctx, fmt.Sprintf("Workflow with ID %s not found: removing from state.", data.ID.ValueString()))
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read workflow", err)
+		return
+	}
+
+	data = workflowBetaFromAPI(ctx, result.JSON200.Workflow, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentWorkflowBetaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state *IncidentWorkflowBetaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data *IncidentWorkflowBetaModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
+
+	payloads := r.toPayloads(ctx, data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	runsOnIncidentModes := []client.WorkflowsUpdateWorkflowPayloadV2RunsOnIncidentModes{}
+	for _, v := range data.RunsOnIncidentModes.Elements() {
+		if str, ok := v.(types.String); ok {
+			runsOnIncidentModes = append(runsOnIncidentModes, client.WorkflowsUpdateWorkflowPayloadV2RunsOnIncidentModes(str.ValueString()))
+		}
+	}
+
+	payload := client.WorkflowsV2UpdateWorkflowJSONRequestBody{
+		Name:                data.Name.ValueString(),
+		ConditionGroups:     payloads.conditionGroups,
+		Steps:               payloads.steps,
+		Expressions:         payloads.expressions,
+		OnceFor:             workflowBetaOnceFor(data.OnceFor),
+		RunsOnIncidents:     client.WorkflowsUpdateWorkflowPayloadV2RunsOnIncidents(data.RunsOnIncidents.ValueString()),
+		RunsOnIncidentModes: runsOnIncidentModes,
+		Folder:              data.Folder.ValueStringPointer(),
+		Shortform:           data.Shortform.ValueStringPointer(),
+		OwningTeamIds:       toOwningTeamIDs(data.OwningTeamIDs),
+		ContinueOnStepError: data.ContinueOnStepError.ValueBool(),
+		State:               lo.ToPtr(client.WorkflowsUpdateWorkflowPayloadV2State(data.State.ValueString())),
+		Annotations: &map[string]string{
+			"incident.io/terraform/version": r.terraformVersion,
+		},
+		SkipStepUpgrades: lo.ToPtr(true),
+	}
+	if scope, ok := knownString(data.PrivateIncidentScope); ok {
+		payload.PrivateIncidentScope = lo.ToPtr(client.WorkflowsUpdateWorkflowPayloadV2PrivateIncidentScope(scope))
+	}
+	if !data.IncludePrivateEscalations.IsNull() && !data.IncludePrivateEscalations.IsUnknown() {
+		payload.IncludePrivateEscalations = lo.ToPtr(data.IncludePrivateEscalations.ValueBool())
+	}
+	if data.Delay != nil {
+		payload.Delay = &client.WorkflowDelayV2{
+			ConditionsApplyOverDelay: data.Delay.ConditionsApplyOverDelay.ValueBool(),
+			ForSeconds:               data.Delay.ForSeconds.ValueInt64(),
+		}
+	}
+
+	result, err := r.client.WorkflowsV2UpdateWorkflowWithResponse(ctx, state.ID.ValueString(), payload)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update workflow", err)
+		return
+	}
+
+	data = workflowBetaFromAPI(ctx, result.JSON200.Workflow, data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
+}
+
+func (r *IncidentWorkflowBetaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data *IncidentWorkflowBetaModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
+	_, err := r.client.WorkflowsV2DestroyWorkflowWithResponse(ctx, data.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete workflow", err)
+		return
+	}
+}
+
+func (r *IncidentWorkflowBetaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeWorkflow, r.terraformVersion)
+}
+
+func (r *IncidentWorkflowBetaResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
+}
+
+// MoveState takes over an incident_workflow's state from a `moved` block. The V2 state holds
+// the same engine objects the API does, so it's read exactly as a response would be: nothing
+// the old resource stored is lost, only respelled.
+func (r *IncidentWorkflowBetaResource) MoveState(ctx context.Context) []resource.StateMover {
+	source := &resource.SchemaResponse{}
+	(&IncidentWorkflowResource{}).Schema(ctx, resource.SchemaRequest{}, source)
+
+	return []resource.StateMover{
+		{
+			SourceSchema: &source.Schema,
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != "incident_workflow" || !strings.HasSuffix(req.SourceProviderAddress, "incident-io/incident") {
+					return
+				}
+
+				if req.SourceState == nil {
+					resp.Diagnostics.AddError(
+						"Unable to move workflow state",
+						"The incident_workflow state doesn't match the schema this provider version expects. Run `terraform apply` with the incident_workflow resource first, then move it.",
+					)
+					return
+				}
+
+				var prior IncidentWorkflowResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				data := workflowBetaFromV2State(ctx, &prior)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+			},
+		},
+	}
+}
+
+// workflowBetaPayloads is everything the V3 grammar maps, in the V2 types the workflows API
+// takes.
+type workflowBetaPayloads struct {
+	conditionGroups []client.ConditionGroupPayloadV2
+	expressions     []client.ExpressionPayloadV2
+	steps           []client.StepConfigPayloadV2
+}
+
+func (r *IncidentWorkflowBetaResource) toPayloads(ctx context.Context, data *IncidentWorkflowBetaModel, diags *diag.Diagnostics) workflowBetaPayloads {
+	expressions, _, err := models.ExpressionsToPayload(workflowExpressions, nil, data.NamedExpressions)
+	if err != nil {
+		diags.AddAttributeError(path.Root("named_expression"), "Invalid expression", err.Error())
+	}
+
+	conditionGroups, err := models.ConditionGroupsToPayload(data.Conditions, data.ConditionGroups)
+	if err != nil {
+		diags.AddAttributeError(path.Root("conditions"), "Invalid conditions", err.Error())
+	}
+
+	steps := []client.StepConfigPayloadV2{}
+	for idx, step := range data.Steps {
+		params := []client.EngineParamBindingPayloadV3{}
+		for paramIdx, param := range step.ParamBindings {
+			payload, err := models.StepParamBindingToPayload(param)
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("step").AtListIndex(idx).AtName("param_bindings").AtListIndex(paramIdx),
+					"Invalid value", err.Error())
+			}
+			params = append(params, payload)
+		}
+
+		steps = append(steps, client.StepConfigPayloadV2{
+			Id:            step.ID.ValueString(),
+			Name:          step.Name.ValueString(),
+			ForEach:       step.ForEach.ValueStringPointer(),
+			ParamBindings: forceCoerce[[]client.EngineParamBindingPayloadV2](ctx, params),
+		})
+	}
+
+	return workflowBetaPayloads{
+		conditionGroups: forceCoerce[[]client.ConditionGroupPayloadV2](ctx, conditionGroups),
+		expressions:     forceCoerce[[]client.ExpressionPayloadV2](ctx, expressions),
+		steps:           steps,
+	}
+}
+
+// workflowBetaFromAPI reads a workflow back. prior is the plan on create and update, the
+// prior state on read, and holds only an ID on import.
+//
+// The response carries labels the payload types don't, so it's first put through the V2
+// model, whose ToPayload drops them, and then coerced to the identically shaped V3 types.
+func workflowBetaFromAPI(ctx context.Context, workflow client.WorkflowV2, prior *IncidentWorkflowBetaModel) *IncidentWorkflowBetaModel {
+	steps := []workflowBetaAPIStep{}
+	for _, step := range workflow.Steps {
+		steps = append(steps, workflowBetaAPIStep{
+			id:            step.Id,
+			name:          step.Name,
+			forEach:       step.ForEach,
+			paramBindings: models.IncidentEngineParamBindings{}.FromAPI(step.ParamBindings),
+		})
+	}
+
+	model := workflowBetaFromPayloads(
+		ctx,
+		models.IncidentEngineConditionGroups{}.FromAPI(workflow.ConditionGroups).ToPayload(),
+		models.IncidentEngineExpressions{}.FromAPI(workflow.Expressions).ToPayload(),
+		steps,
+		prior,
+	)
+
+	model.ID = types.StringValue(workflow.Id)
+	model.Name = types.StringValue(workflow.Name)
+	model.Trigger = types.StringValue(workflow.Trigger.Name)
+	model.Folder = types.StringPointerValue(workflow.Folder)
+	model.Shortform = types.StringPointerValue(workflow.Shortform)
+	model.OnceFor = buildOnceFor(workflow.OnceFor)
+	model.PrivateIncidentScope = types.StringValue(string(workflow.PrivateIncidentScope))
+	model.IncludePrivateEscalations = types.BoolValue(workflow.IncludePrivateEscalations)
+	model.OwningTeamIDs = buildOwningTeamIDs(workflow.OwningTeamIds)
+	model.ContinueOnStepError = types.BoolValue(workflow.ContinueOnStepError)
+	model.RunsOnIncidents = types.StringValue(string(workflow.RunsOnIncidents))
+	model.RunsOnIncidentModes = buildRunsOnIncidentModes(workflow.RunsOnIncidentModes)
+	model.State = types.StringValue(string(workflow.State))
+	model.Timeouts = prior.Timeouts
+	if workflow.Delay != nil {
+		model.Delay = &IncidentWorkflowDelay{
+			ConditionsApplyOverDelay: types.BoolValue(workflow.Delay.ConditionsApplyOverDelay),
+			ForSeconds:               types.Int64Value(workflow.Delay.ForSeconds),
+		}
+	}
+
+	return model
+}
+
+// workflowBetaFromV2State converts an incident_workflow's state. It has no prior in the new
+// schema, so each value takes the narrowest spelling that holds it, as on import.
+func workflowBetaFromV2State(ctx context.Context, v2 *IncidentWorkflowResourceModel) IncidentWorkflowBetaModel {
+	steps := []workflowBetaAPIStep{}
+	for _, step := range v2.Steps {
+		steps = append(steps, workflowBetaAPIStep{
+			id:            step.ID.ValueString(),
+			name:          step.Name.ValueString(),
+			forEach:       step.ForEach.ValueStringPointer(),
+			paramBindings: step.ParamBindings,
+		})
+	}
+
+	model := workflowBetaFromPayloads(ctx, v2.ConditionGroups.ToPayload(), v2.Expressions.ToPayload(), steps, nil)
+
+	model.ID = v2.ID
+	model.Name = v2.Name
+	model.Trigger = v2.Trigger
+	model.Folder = v2.Folder
+	model.Shortform = v2.Shortform
+	model.OnceFor = v2.OnceFor
+	model.PrivateIncidentScope = v2.PrivateIncidentScope
+	model.IncludePrivateEscalations = v2.IncludePrivateEscalations
+	model.OwningTeamIDs = v2.OwningTeamIDs
+	model.ContinueOnStepError = v2.ContinueOnStepError
+	model.Delay = v2.Delay
+	model.RunsOnIncidents = v2.RunsOnIncidents
+	model.RunsOnIncidentModes = v2.RunsOnIncidentModes
+	model.State = v2.State
+	model.Timeouts = v2.Timeouts
+
+	return *model
+}
+
+type workflowBetaAPIStep struct {
+	id            string
+	name          string
+	forEach       *string
+	paramBindings models.IncidentEngineParamBindings
+}
+
+// workflowBetaFromPayloads maps the engine objects a response and an incident_workflow's state
+// share onto the V3 grammar.
+func workflowBetaFromPayloads(
+	ctx context.Context,
+	conditionGroupsV2 []client.ConditionGroupPayloadV2,
+	expressionsV2 []client.ExpressionPayloadV2,
+	steps []workflowBetaAPIStep,
+	prior *IncidentWorkflowBetaModel,
+) *IncidentWorkflowBetaModel {
+	if prior == nil {
+		prior = &IncidentWorkflowBetaModel{}
+	}
+
+	conditionGroups := toV3Payload[[]client.ConditionGroupPayloadV3](ctx, conditionGroupsV2)
+	expressions := toV3Payload[[]client.ExpressionPayloadV3](ctx, expressionsV2)
+
+	if planned, _, err := models.ExpressionsToPayload(workflowExpressions, nil, prior.NamedExpressions); err == nil {
+		models.RestorePlannedExpressionOperations(expressions, planned)
+	}
+
+	model := &IncidentWorkflowBetaModel{}
+	model.Conditions, model.ConditionGroups = models.ReconcileConditions(prior.Conditions, prior.ConditionGroups, conditionGroups)
+	_, model.NamedExpressions = models.ExpressionsFromPayload(expressions, workflowExpressions, nil, prior.NamedExpressions)
+
+	// Keyed by step ID so a reordering doesn't compare one step's params with another's.
+	priorSteps := map[string]IncidentWorkflowBetaStep{}
+	for _, step := range prior.Steps {
+		priorSteps[step.ID.ValueString()] = step
+	}
+
+	for _, step := range steps {
+		priorStep, seen := priorSteps[step.id]
+
+		paramBindings := step.paramBindings
+		if seen {
+			paramBindings = paramBindings.TrimAppendedEmpty(len(priorStep.ParamBindings))
+		}
+
+		var params []models.StepParamBinding
+		for idx, binding := range toV3Payload[[]client.EngineParamBindingPayloadV3](ctx, paramBindings.ToPayload()) {
+			var priorParam *models.StepParamBinding
+			if idx < len(priorStep.ParamBindings) {
+				priorParam = &priorStep.ParamBindings[idx]
+			}
+			params = append(params, models.ReconcileStepParamBinding(ctx, priorParam, binding))
+		}
+		if params == nil && priorStep.ParamBindings != nil {
+			params = []models.StepParamBinding{}
+		}
+
+		model.Steps = append(model.Steps, IncidentWorkflowBetaStep{
+			ID:            types.StringValue(step.id),
+			Name:          types.StringValue(step.name),
+			ForEach:       types.StringPointerValue(step.forEach),
+			ParamBindings: params,
+		})
+	}
+
+	return model
+}
+
+// toV3Payload coerces V2 engine payloads to their V3 twins. V2's ToPayload always sends an
+// array_value, empty or not, where the V3 mapping leaves an unused one out: left in, no prior
+// would ever compare equal to what the API returned.
+func toV3Payload[T any](ctx context.Context, input any) T {
+	return forceCoerce[T](ctx, pruneEmptyArrayValues(forceCoerce[any](ctx, input)))
+}
+
+func pruneEmptyArrayValues(node any) any {
+	switch node := node.(type) {
+	case map[string]any:
+		for key, value := range node {
+			if values, ok := value.([]any); ok && key == "array_value" && len(values) == 0 {
+				delete(node, key)
+				continue
+			}
+			node[key] = pruneEmptyArrayValues(value)
+		}
+	case []any:
+		for idx := range node {
+			node[idx] = pruneEmptyArrayValues(node[idx])
+		}
+	}
+
+	return node
+}
+
+func workflowBetaOnceFor(onceFor []types.String) []string {
+	out := []string{}
+	for _, v := range onceFor {
+		out = append(out, v.ValueString())
+	}
+
+	return out
+}
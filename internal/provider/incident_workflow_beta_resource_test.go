@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccIncidentWorkflowBetaResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and check state
+			{
+				Config: testAccIncidentWorkflowBetaConfig("open", "{{ incident.name }} needs a postmortem"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_workflow_beta.example", "conditions.0.params.0.values.0", "open"),
+					resource.TestCheckResourceAttr(
+						"incident_workflow_beta.example", "step.0.param_bindings.1.rich_text", "{{ incident.name }} needs a postmortem"),
+					resource.TestCheckResourceAttr(
+						"incident_workflow_beta.example", "named_expression.0.name", "participants_cnt"),
+				),
+			},
+			// Import
+			{
+				ResourceName:            "incident_workflow_beta.example",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"step.0.param_bindings.1.rich_text"},
+			},
+			// Update the template and check new state
+			{
+				Config: testAccIncidentWorkflowBetaConfig("open", "Write up {{ incident.name }}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_workflow_beta.example", "step.0.param_bindings.1.rich_text", "Write up {{ incident.name }}"),
+				),
+			},
+			// Update conditions and check new state
+			{
+				Config: testAccIncidentWorkflowBetaConfig("closed", "Write up {{ incident.name }}"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"incident_workflow_beta.example", "conditions.0.params.0.values.0", "closed"),
+				),
+			},
+		},
+	})
+}
+
+// TestAccIncidentWorkflowBetaResourceMovedFromV2 moves an incident_workflow into the beta
+// resource, then rewrites the config in the new schema: the workflow must be neither
+// replaced nor changed.
+func TestAccIncidentWorkflowBetaResourceMovedFromV2(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIncidentWorkflowResourceConfig(nil),
+			},
+			{
+				Config: fmt.Sprintf(`
+moved {
+	from = incident_workflow.example
+	to   = incident_workflow_beta.example
+}
+%s`, testAccIncidentWorkflowBetaMovedConfig()),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("incident_workflow_beta.example", plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccIncidentWorkflowBetaConfig(status, message string) string {
+	return fmt.Sprintf(`
+resource "incident_workflow_beta" "example" {
+	name    = %[1]q
+	trigger = "incident.updated"
+
+	conditions = [{
+		subject   = "incident.status.category"
+		operation = "one_of"
+		params    = [{ values = [%[2]q] }]
+	}]
+
+	named_expression {
+		name       = "participants_cnt"
+		label      = "Count active participants"
+		start_from = "incident.active_participants"
+
+		operation {
+			count = {}
+		}
+	}
+
+	step {
+		id   = "01HXVEA7Y0VWQBJB4F2X8WNRW6"
+		name = "incident.create_follow_ups"
+		param_bindings = [
+			{ value_reference = "incident" },
+			{ rich_text = %[3]q },
+			{},
+		]
+	}
+
+	once_for               = ["incident"]
+	continue_on_step_error = false
+	runs_on_incidents      = "newly_created"
+	runs_on_incident_modes = ["standard"]
+	state                  = "draft"
+}
+`, StableSuffix("My Test Workflow"), status, message)
+}
+
+// testAccIncidentWorkflowBetaMovedConfig is incidentWorkflowTemplate's defaults, rewritten in
+// the beta schema.
+func testAccIncidentWorkflowBetaMovedConfig() string {
+	defaults := incidentWorkflowDefault()
+
+	return fmt.Sprintf(`
+resource "incident_workflow_beta" "example" {
+	name    = %[1]q
+	trigger = "incident.updated"
+
+	conditions = [{
+		subject   = "incident.status.category"
+		operation = "one_of"
+		params    = [{ values = [%[2]q] }]
+	}]
+
+	named_expression {
+		name       = "participants_cnt"
+		label      = %[4]q
+		start_from = "incident.active_participants"
+
+		operation {
+			count = {}
+		}
+	}
+
+	step {
+		id   = "01HXVEA7Y0VWQBJB4F2X8WNRW6"
+		name = "incident.create_follow_ups"
+		param_bindings = [
+			{ value_reference = "incident" },
+			{ values = [%[3]q] },
+			{},
+		]
+	}
+
+	once_for               = ["incident"]
+	private_incident_scope = "none"
+	continue_on_step_error = false
+	runs_on_incidents      = "newly_created"
+	runs_on_incident_modes = ["standard"]
+	state                  = "draft"
+}
+`, defaults.Name, defaults.ConditionParam, defaults.StepFollowUpName, defaults.ExpressionLabel)
+}
@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/richtexttypes"
+)
+
+// workflowBetaTestModel is a valid workflow with a step reading a named expression, so each
+// case only has to break the one thing it's checking.
+func workflowBetaTestModel() IncidentWorkflowBetaModel {
+	return IncidentWorkflowBetaModel{
+		ID:                        types.StringNull(),
+		Name:                      types.StringValue("Page the on-call"),
+		Folder:                    types.StringNull(),
+		Shortform:                 types.StringNull(),
+		Trigger:                   types.StringValue("incident.updated"),
+		OnceFor:                   []types.String{types.StringValue("incident")},
+		PrivateIncidentScope:      types.StringNull(),
+		IncludePrivateEscalations: types.BoolNull(),
+		OwningTeamIDs:             types.SetNull(types.StringType),
+		ContinueOnStepError:       types.BoolValue(false),
+		RunsOnIncidents:           types.StringValue("newly_created"),
+		RunsOnIncidentModes:       types.SetValueMust(types.StringType, []attr.Value{types.StringValue("standard")}),
+		State:                     types.StringValue("draft"),
+		NamedExpressions: []models.NamedExpression{{
+			Name:       types.StringValue("participants"),
+			Label:      types.StringNull(),
+			StartFrom:  types.StringValue("incident.active_participants"),
+			Operations: []models.Operation{{Count: &models.EmptyOpts{}}},
+		}},
+		Steps: []IncidentWorkflowBetaStep{{
+			ID:      types.StringValue("01HXVEA7Y0VWQBJB4F2X8WNRW6"),
+			Name:    types.StringValue("slack.post_message"),
+			ForEach: types.StringNull(),
+			ParamBindings: []models.StepParamBinding{
+				stepParam(func(p *models.StepParamBinding) { p.ExpressionRef = types.StringValue("participants") }),
+				stepParam(func(p *models.StepParamBinding) {
+					p.RichText = richtexttypes.NewTemplatedTextValue("{{ incident.name }} needs you")
+				}),
+			},
+		}},
+		Timeouts: nullTimeouts(context.Background()),
+	}
+}
+
+func stepParam(set func(*models.StepParamBinding)) models.StepParamBinding {
+	param := models.StepParamBinding{
+		ValueLiteral:   types.StringNull(),
+		ValueReference: types.StringNull(),
+		ExpressionRef:  types.StringNull(),
+		RichText:       richtexttypes.NewTemplatedTextNull(),
+	}
+	set(&param)
+
+	return param
+}
+
+// validateWorkflowBeta writes the model into a config against the real schema, so
+// ValidateConfig decodes it exactly as it would Terraform's.
+func validateWorkflowBeta(t *testing.T, model IncidentWorkflowBetaModel) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewIncidentWorkflowBetaResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &model); diags.HasError() {
+		t.Fatalf("building the config: %+v", diags)
+	}
+
+	r, ok := NewIncidentWorkflowBetaResource().(*IncidentWorkflowBetaResource)
+	if !ok {
+		t.Fatalf("NewIncidentWorkflowBetaResource did not return a *IncidentWorkflowBetaResource")
+	}
+
+	resp := resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+	}, &resp)
+
+	return resp.Diagnostics
+}
+
+func TestIncidentWorkflowBetaValidateConfig(t *testing.T) {
+	t.Run("a valid workflow passes", func(t *testing.T) {
+		if diags := validateWorkflowBeta(t, workflowBetaTestModel()); diags.HasError() {
+			t.Errorf("unexpected diagnostics: %+v", diags)
+		}
+	})
+
+	t.Run("a param referencing an unknown expression", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.Steps[0].ParamBindings[0].ExpressionRef = types.StringValue("participant")
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "participant")
+	})
+
+	t.Run("an empty param skips its place", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.Steps[0].ParamBindings = append(model.Steps[0].ParamBindings, stepParam(func(*models.StepParamBinding) {}))
+
+		if diags := validateWorkflowBeta(t, model); diags.HasError() {
+			t.Errorf("unexpected diagnostics: %+v", diags)
+		}
+	})
+
+	t.Run("rich_text alongside another form", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.Steps[0].ParamBindings[1].ValueLiteral = types.StringValue("hello")
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "Ambiguous value")
+	})
+
+	t.Run("for_each naming an unknown expression", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.Steps[0].ForEach = types.StringValue("responders")
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "Unknown for_each")
+	})
+
+	t.Run("a condition referencing an unknown expression", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.Conditions = []models.Condition{{
+			Subject:   types.StringValue("incident.severity"),
+			Operation: types.StringValue("one_of"),
+			Params:    []models.Binding{{ExpressionRef: types.StringValue("severity_lookup")}},
+		}}
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "severity_lookup")
+	})
+
+	t.Run("cast isn't supported", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.NamedExpressions[0].Operations = append(model.NamedExpressions[0].Operations, models.Operation{
+			Cast: &models.Cast{As: types.StringValue("String")},
+		})
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "Unsupported operation")
+	})
+
+	t.Run("an unknown private_incident_scope", func(t *testing.T) {
+		model := workflowBetaTestModel()
+		model.PrivateIncidentScope = types.StringValue("everything")
+
+		assertErrorContaining(t, validateWorkflowBeta(t, model), "Invalid private_incident_scope")
+	})
+}
+
+func TestIncidentWorkflowBetaValidateConfigStepIDs(t *testing.T) {
+	model := workflowBetaTestModel()
+	model.Steps = append(model.Steps, model.Steps[0])
+
+	assertErrorContaining(t, validateWorkflowBeta(t, model), "Duplicate step ID")
+}
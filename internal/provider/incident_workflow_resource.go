@@ -5,7 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/oklog/ulid/v2"
 	"github.com/samber/lo"
 
 	"github.com/incident-io/terraform-provider-incident/internal/apischema"
@@ -24,6 +27,7 @@ var (
 	_ resource.Resource                   = &IncidentWorkflowResource{}
 	_ resource.ResourceWithImportState    = &IncidentWorkflowResource{}
 	_ resource.ResourceWithValidateConfig = &IncidentWorkflowResource{}
+	_ resource.ResourceWithIdentity       = &IncidentWorkflowResource{}
 )
 
 // privateIncidentScopes are the valid values for the private_incident_scope attribute.
@@ -38,6 +42,10 @@ func NewIncidentWorkflowResource() resource.Resource {
 	return &IncidentWorkflowResource{}
 }
 
+func NewIncidentWorkflowListResource() list.ListResource {
+	return newIDListResource(NewIncidentWorkflowResource, listWorkflowResources)
+}
+
 type IncidentWorkflowResourceModel struct {
 	ID                        types.String                         `tfsdk:"id"`
 	Name                      types.String                         `tfsdk:"name"`
@@ -57,6 +65,7 @@ type IncidentWorkflowResourceModel struct {
 	RunsOnIncidents           types.String                         `tfsdk:"runs_on_incidents"`
 	RunsOnIncidentModes       types.Set                            `tfsdk:"runs_on_incident_modes"`
 	State                     types.String                         `tfsdk:"state"`
+	Timeouts                  timeouts.Value                       `tfsdk:"timeouts"`
 }
 
 type IncidentWorkflowStep struct {
@@ -185,6 +194,9 @@ We'd generally recommend building workflows in our [web dashboard](https://app.i
 				Required:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx),
+		},
 	}
 }
 
@@ -195,6 +207,9 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Create, &resp.Diagnostics)
+	defer cancel()
+
 	onceFor := []string{}
 	for _, v := range data.OnceFor {
 		onceFor = append(onceFor, v.ValueString())
@@ -253,7 +268,7 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 
 	result, err := r.client.WorkflowsV2CreateWorkflowWithResponse(ctx, payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workflow, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to create workflow", err)
 		return
 	}
 
@@ -262,20 +277,19 @@ func (r *IncidentWorkflowResource) Create(ctx context.Context, req resource.Crea
 	// carry param_bindings the plan never set.
 	data = r.buildModel(ctx, result.JSON201.Workflow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentWorkflowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var state *IncidentWorkflowResourceModel
+	var data, state *IncidentWorkflowResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	var data *IncidentWorkflowResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Update, &resp.Diagnostics)
+	defer cancel()
 
 	onceFor := []string{}
 	for _, v := range data.OnceFor {
@@ -335,12 +349,13 @@ func (r *IncidentWorkflowResource) Update(ctx context.Context, req resource.Upda
 
 	result, err := r.client.WorkflowsV2UpdateWorkflowWithResponse(ctx, state.ID.ValueString(), payload)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workflow, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to update workflow", err)
 		return
 	}
 
 	data = r.buildModel(ctx, result.JSON200.Workflow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentWorkflowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -350,6 +365,9 @@ func (r *IncidentWorkflowResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Read, &resp.Diagnostics)
+	defer cancel()
+
 	result, err := r.client.WorkflowsV2ShowWorkflowWithResponse(ctx, data.ID.ValueString(), &client.WorkflowsV2ShowWorkflowParams{
 		SkipStepUpgrades: lo.ToPtr(true),
 	})
@@ -361,12 +379,13 @@ func (r *IncidentWorkflowResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to read workflow", err)
 		return
 	}
 
 	data = r.buildModel(ctx, result.JSON200.Workflow, data)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	setIDIdentity(ctx, resp.Identity, data.ID, &resp.Diagnostics)
 }
 
 func (r *IncidentWorkflowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -376,16 +395,27 @@ func (r *IncidentWorkflowResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
+	ctx, cancel := withTimeout(ctx, data.Timeouts.Delete, &resp.Diagnostics)
+	defer cancel()
+
 	_, err := r.client.WorkflowsV2DestroyWorkflowWithResponse(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workflow, got error: %s", err))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to delete workflow", err)
 		return
 	}
 }
 
 func (r *IncidentWorkflowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	claimResource(ctx, r.client, req.ID, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeWorkflow, r.terraformVersion)
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id := importStateByID(ctx, req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	claimResource(ctx, r.client, id, &resp.Diagnostics, client.ManagedResourcesCreateManagedResourcePayloadV2ResourceTypeWorkflow, r.terraformVersion)
+}
+
+func (r *IncidentWorkflowResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema()
 }
 
 func (r *IncidentWorkflowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -426,6 +456,8 @@ func toOwningTeamIDs(set types.Set) *[]string {
 // that contradicts it. The bool is true whenever the scope touches private incidents (all or
 // owning_teams), false for none; the API accepts both when they agree, so only disagreement errors.
 func (r *IncidentWorkflowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateWorkflowSteps(ctx, req, &resp.Diagnostics)
+
 	var includePrivate types.Bool
 	var scope types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("include_private_incidents"), &includePrivate)...)
@@ -456,6 +488,60 @@ func (r *IncidentWorkflowResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// validateWorkflowSteps catches a malformed step at plan time, at the step's own path,
+// rather than as a rejection of the whole workflow at apply. It checks only what the config
+// shows: the API has no list of steps and their params, so an unknown step name, a missing
+// param or a literal of the wrong type is still only caught at apply.
+func validateWorkflowSteps(ctx context.Context, req resource.ValidateConfigRequest, diags *diag.Diagnostics) {
+	var steps []IncidentWorkflowStep
+	var expressions models.IncidentEngineExpressions
+
+	// Either can hold values computed by another resource, which won't decode until they're
+	// known. Terraform validates again once they are.
+	if req.Config.GetAttribute(ctx, path.Root("steps"), &steps).HasError() {
+		return
+	}
+	var known map[string]bool
+	if !req.Config.GetAttribute(ctx, path.Root("expressions"), &expressions).HasError() {
+		known = expressions.References()
+	}
+
+	seen := map[string]bool{}
+	for idx, step := range steps {
+		at := path.Root("steps").AtListIndex(idx)
+
+		validateWorkflowStepID(step.ID, at.AtName("id"), seen, diags)
+
+		if forEach, ok := knownString(step.ForEach); ok && known != nil && !known[forEach] {
+			diags.AddAttributeError(
+				at.AtName("for_each"),
+				"Unknown for_each",
+				fmt.Sprintf("No expression in this workflow has the reference %q.", forEach),
+			)
+		}
+
+		step.ParamBindings.Validate(at.AtName("param_bindings"), known, diags)
+	}
+}
+
+// validateWorkflowStepID checks a step's ID is a ULID, and unique among the IDs already seen.
+// Steps are matched by ID across updates, so a duplicate would run one step's params against
+// another.
+func validateWorkflowStepID(id types.String, at path.Path, seen map[string]bool, diags *diag.Diagnostics) {
+	value, ok := knownString(id)
+	if !ok {
+		return
+	}
+
+	if _, err := ulid.ParseStrict(value); err != nil {
+		diags.AddAttributeError(at, "Invalid step ID", fmt.Sprintf("A step's ID must be a ULID, such as \"01HXVEA7Y0VWQBJB4F2X8WNRW6\", got %q.", value))
+	}
+	if seen[value] {
+		diags.AddAttributeError(at, "Duplicate step ID", fmt.Sprintf("Another step in this workflow already has the ID %q.", value))
+	}
+	seen[value] = true
+}
+
 func toPayloadSteps(steps []IncidentWorkflowStep) []client.StepConfigPayloadV2 {
 	out := []client.StepConfigPayloadV2{}
 
@@ -470,3 +556,21 @@ func toPayloadSteps(steps []IncidentWorkflowStep) []client.StepConfigPayloadV2 {
 
 	return out
 }
+
+// listWorkflowResources lists all workflows for `terraform query`.
+func listWorkflowResources(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error) {
+	result, err := apiClient.WorkflowsV2ListWorkflowsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if result.JSON200 == nil {
+		return nil, fmt.Errorf("unexpected response listing workflows: %s", result.Status())
+	}
+
+	listed := []listedResource{}
+	for _, item := range result.JSON200.Workflows {
+		listed = append(listed, listedResource{ID: item.Id, Name: item.Name})
+	}
+
+	return listed, nil
+}
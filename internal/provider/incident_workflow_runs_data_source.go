@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+var (
+	_ datasource.DataSource                   = &IncidentWorkflowRunsDataSource{}
+	_ datasource.DataSourceWithConfigure      = &IncidentWorkflowRunsDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &IncidentWorkflowRunsDataSource{}
+)
+
+const (
+	workflowRunListPageSize = 250
+	workflowRunDefaultLimit = 25
+)
+
+// A run has no status of its own, so it's derived from its cancellation, its error and
+// its steps'. The step statuses are complete, error and pending, and a run's reuse them.
+var workflowRunStatuses = []string{"complete", "error", "pending", "cancelled"}
+
+func NewIncidentWorkflowRunsDataSource() datasource.DataSource {
+	return &IncidentWorkflowRunsDataSource{}
+}
+
+type IncidentWorkflowRunsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentWorkflowRunsDataSourceModel struct {
+	WorkflowID    types.String               `tfsdk:"workflow_id"`
+	IncidentID    types.String               `tfsdk:"incident_id"`
+	Status        types.String               `tfsdk:"status"`
+	CreatedAfter  types.String               `tfsdk:"created_after"`
+	CreatedBefore types.String               `tfsdk:"created_before"`
+	Limit         types.Int64                `tfsdk:"limit"`
+	Runs          []IncidentWorkflowRunModel `tfsdk:"runs"`
+}
+
+type IncidentWorkflowRunModel struct {
+	ID                    types.String                   `tfsdk:"id"`
+	WorkflowID            types.String                   `tfsdk:"workflow_id"`
+	WorkflowName          types.String                   `tfsdk:"workflow_name"`
+	WorkflowVersionNumber types.Int64                    `tfsdk:"workflow_version_number"`
+	IncidentID            types.String                   `tfsdk:"incident_id"`
+	IncidentReference     types.String                   `tfsdk:"incident_reference"`
+	Status                types.String                   `tfsdk:"status"`
+	Error                 types.String                   `tfsdk:"error"`
+	CreatedAt             types.String                   `tfsdk:"created_at"`
+	CancelledAt           types.String                   `tfsdk:"cancelled_at"`
+	Steps                 []IncidentWorkflowRunStepModel `tfsdk:"steps"`
+}
+
+type IncidentWorkflowRunStepModel struct {
+	Step              types.String `tfsdk:"step"`
+	Status            types.String `tfsdk:"status"`
+	Error             types.String `tfsdk:"error"`
+	IncidentID        types.String `tfsdk:"incident_id"`
+	IncidentReference types.String `tfsdk:"incident_reference"`
+	CompletedAt       types.String `tfsdk:"completed_at"`
+	WebhookOutcome    types.String `tfsdk:"webhook_outcome"`
+	WebhookStatusCode types.Int64  `tfsdk:"webhook_status_code"`
+}
+
+func (d *IncidentWorkflowRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_runs"
+}
+
+func (d *IncidentWorkflowRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `List the most recent runs of your workflows, with the outcome of each step.
+
+Use it after changing a workflow to check that it still works, for example in a ` + "`check`" + ` block
+that fails when any of the workflow's recent runs errored.
+
+Runs are listed newest first, and only as many are read as it takes to find ` + "`limit`" + ` of them.
+` + "`status`" + ` is worked out from each run rather than filtered on by the API, so a ` + "`status`" + ` that few
+runs have can mean reading every run in the time range: set ` + "`created_after`" + ` for a workflow
+that runs often.`,
+		Attributes: map[string]schema.Attribute{
+			"workflow_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only list runs of this workflow.",
+			},
+			"incident_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only list runs against this incident.",
+			},
+			"status": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "If set, only list runs with this status. Possible values are: " +
+					backtickValues(workflowRunStatuses) + ".",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only list runs created at or after this RFC3339 timestamp.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"created_before": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "If set, only list runs created at or before this RFC3339 timestamp.",
+				Validators: []validator.String{
+					RFC3339TimestampValidator{},
+				},
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The most runs to list. Defaults to %d.", workflowRunDefaultLimit),
+			},
+			"runs": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The runs matching every filter, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "id"),
+						},
+						"workflow_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "workflow_id"),
+						},
+						"workflow_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "workflow_name"),
+						},
+						"workflow_version_number": schema.Int64Attribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "workflow_version_number"),
+						},
+						"incident_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "incident_id"),
+						},
+						"incident_reference": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "incident_reference"),
+						},
+						"status": schema.StringAttribute{
+							Computed: true,
+							MarkdownDescription: "`cancelled` if the run was cancelled, `error` if it or any of its steps " +
+								"failed, `pending` while any step has yet to finish, and otherwise `complete`.",
+						},
+						"error": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: apischema.Docstring("WorkflowRunSlimV2", "error"),
+						},
+						"created_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "When the run was created, as an RFC3339 timestamp.",
+						},
+						"cancelled_at": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "If the run was cancelled, when, as an RFC3339 timestamp.",
+						},
+						"steps": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The outcome of each step, in the order they ran.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"step": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "The name of the step, such as `slack.post_message`.",
+									},
+									"status": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: EnumValuesDescription("StepProgressSlimV2", "status"),
+									},
+									"error": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("StepProgressSlimV2", "error"),
+									},
+									"incident_id": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("StepProgressSlimV2", "incident_id"),
+									},
+									"incident_reference": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: apischema.Docstring("StepProgressSlimV2", "incident_reference"),
+									},
+									"completed_at": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "When the step finished, as an RFC3339 timestamp.",
+									},
+									"webhook_outcome": schema.StringAttribute{
+										Computed:            true,
+										MarkdownDescription: "For a `webhook.send` step, the result of the delivery. " + EnumValuesDescription("WebhookDeliverySlimV2", "outcome"),
+									},
+									"webhook_status_code": schema.Int64Attribute{
+										Computed:            true,
+										MarkdownDescription: "For a `webhook.send` step, the HTTP status code the endpoint returned. Null when it didn't respond.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *IncidentWorkflowRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	d.client = data.Client
+}
+
+func (d *IncidentWorkflowRunsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var status types.String
+	var limit types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &status)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("limit"), &limit)...)
+
+	if value, ok := knownString(status); ok && !slices.Contains(workflowRunStatuses, value) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("status"),
+			"Invalid status",
+			fmt.Sprintf("Expected one of %v, got %q.", workflowRunStatuses, value),
+		)
+	}
+	if value, ok := knownInt64(limit); ok && value < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limit"),
+			"Invalid limit",
+			fmt.Sprintf("limit must be at least 1, got %d.", value),
+		)
+	}
+}
+
+func (d *IncidentWorkflowRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data IncidentWorkflowRunsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	params := &client.WorkflowRunsV2ListParams{
+		WorkflowId: data.WorkflowID.ValueStringPointer(),
+		IncidentId: data.IncidentID.ValueStringPointer(),
+		PageSize:   lo.ToPtr(int64(workflowRunListPageSize)),
+	}
+	createdAt := map[string][]string{}
+	if after, ok := knownString(data.CreatedAfter); ok {
+		createdAt["gte"] = []string{after}
+	}
+	if before, ok := knownString(data.CreatedBefore); ok {
+		createdAt["lte"] = []string{before}
+	}
+	if len(createdAt) > 0 {
+		params.CreatedAt = &createdAt
+	}
+
+	limit := workflowRunDefaultLimit
+	if value, ok := knownInt64(data.Limit); ok {
+		limit = int(value)
+	}
+
+	runs, err := listWorkflowRuns(ctx, d.client, params, data.Status, limit)
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to list workflow runs", err)
+		return
+	}
+
+	data.Runs = []IncidentWorkflowRunModel{}
+	for _, run := range newestWorkflowRuns(runs, data.Status, limit) {
+		data.Runs = append(data.Runs, buildWorkflowRunModel(run))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listWorkflowRuns returns runs matching the params, following the pagination cursor
+// until it has found limit runs with the status, or reached the last page. The API lists
+// runs newest first, so those are the newest limit runs with the status.
+func listWorkflowRuns(ctx context.Context, apiClient *client.ClientWithResponses, params *client.WorkflowRunsV2ListParams, status types.String, limit int) ([]client.WorkflowRunSlimV2, error) {
+	runs := []client.WorkflowRunSlimV2{}
+	matching := 0
+
+	for {
+		result, err := apiClient.WorkflowRunsV2ListWithResponse(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+		if result.JSON200 == nil {
+			return nil, fmt.Errorf("unexpected response: %s", result.Status())
+		}
+
+		runs = append(runs, result.JSON200.WorkflowRuns...)
+		if value, ok := knownString(status); ok {
+			matching += lo.CountBy(result.JSON200.WorkflowRuns, func(run client.WorkflowRunSlimV2) bool {
+				return workflowRunStatus(run) == value
+			})
+		} else {
+			matching += len(result.JSON200.WorkflowRuns)
+		}
+
+		if matching >= limit {
+			break
+		}
+		if result.JSON200.PaginationMeta == nil || result.JSON200.PaginationMeta.After == nil {
+			break
+		}
+		params.After = result.JSON200.PaginationMeta.After
+	}
+
+	return runs, nil
+}
+
+// newestWorkflowRuns sorts newest first itself, rather than relying on the order of the
+// runs within a page, so that limit always keeps the most recent runs.
+func newestWorkflowRuns(runs []client.WorkflowRunSlimV2, status types.String, limit int) []client.WorkflowRunSlimV2 {
+	if value, ok := knownString(status); ok {
+		runs = lo.Filter(runs, func(run client.WorkflowRunSlimV2, _ int) bool {
+			return workflowRunStatus(run) == value
+		})
+	}
+
+	sorted := slices.Clone(runs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+	})
+
+	if len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+
+	return sorted
+}
+
+func workflowRunStatus(run client.WorkflowRunSlimV2) string {
+	switch {
+	case run.CancelledAt != nil:
+		return "cancelled"
+	case run.Error != nil && *run.Error != "":
+		return "error"
+	case lo.SomeBy(run.Progress, func(step client.StepProgressSlimV2) bool {
+		return step.Status == client.StepProgressSlimV2StatusError
+	}):
+		return "error"
+	case len(run.Progress) == 0, lo.SomeBy(run.Progress, func(step client.StepProgressSlimV2) bool {
+		return step.Status == client.StepProgressSlimV2StatusPending
+	}):
+		return "pending"
+	default:
+		return "complete"
+	}
+}
+
+func buildWorkflowRunModel(run client.WorkflowRunSlimV2) IncidentWorkflowRunModel {
+	return IncidentWorkflowRunModel{
+		ID:                    types.StringValue(run.Id),
+		WorkflowID:            types.StringValue(run.WorkflowId),
+		WorkflowName:          types.StringPointerValue(run.WorkflowName),
+		WorkflowVersionNumber: types.Int64Value(run.WorkflowVersionNumber),
+		IncidentID:            types.StringPointerValue(run.IncidentId),
+		IncidentReference:     types.StringPointerValue(run.IncidentReference),
+		Status:                types.StringValue(workflowRunStatus(run)),
+		Error:                 types.StringPointerValue(run.Error),
+		CreatedAt:             types.StringValue(run.CreatedAt.Format(time.RFC3339)),
+		CancelledAt:           timeStringOrNull(run.CancelledAt),
+		Steps: lo.Map(run.Progress, func(step client.StepProgressSlimV2, _ int) IncidentWorkflowRunStepModel {
+			model := IncidentWorkflowRunStepModel{
+				Step:              types.StringValue(step.Step),
+				Status:            types.StringValue(string(step.Status)),
+				Error:             types.StringPointerValue(step.Error),
+				IncidentID:        types.StringPointerValue(step.IncidentId),
+				IncidentReference: types.StringPointerValue(step.IncidentReference),
+				CompletedAt:       timeStringOrNull(step.CompletedAt),
+				WebhookOutcome:    types.StringNull(),
+				WebhookStatusCode: types.Int64Null(),
+			}
+			if step.WebhookDelivery != nil {
+				model.WebhookOutcome = types.StringValue(string(step.WebhookDelivery.Outcome))
+				model.WebhookStatusCode = types.Int64PointerValue(step.WebhookDelivery.StatusCode)
+			}
+
+			return model
+		}),
+	}
+}
+
+func timeStringOrNull(value *time.Time) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value.Format(time.RFC3339))
+}
@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestAccIncidentWorkflowRunsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "incident_workflow_runs" "recent" {
+  created_after = "2024-01-01T00:00:00Z"
+  limit         = 5
+}
+
+data "incident_workflow_runs" "failed" {
+  status = "error"
+  limit  = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.incident_workflow_runs.recent", "runs.#"),
+					resource.TestCheckResourceAttrSet("data.incident_workflow_runs.failed", "runs.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestWorkflowRunStatus(t *testing.T) {
+	step := func(status client.StepProgressSlimV2Status) client.StepProgressSlimV2 {
+		return client.StepProgressSlimV2{Step: "slack.post_message", Status: status}
+	}
+
+	testCases := []struct {
+		name     string
+		run      client.WorkflowRunSlimV2
+		expected string
+	}{
+		{
+			name: "every step complete",
+			run: client.WorkflowRunSlimV2{Progress: []client.StepProgressSlimV2{
+				step(client.StepProgressSlimV2StatusComplete),
+				step(client.StepProgressSlimV2StatusComplete),
+			}},
+			expected: "complete",
+		},
+		{
+			name: "a step errored",
+			run: client.WorkflowRunSlimV2{Progress: []client.StepProgressSlimV2{
+				step(client.StepProgressSlimV2StatusComplete),
+				step(client.StepProgressSlimV2StatusError),
+				step(client.StepProgressSlimV2StatusPending),
+			}},
+			expected: "error",
+		},
+		{
+			name: "the run errored before any step",
+			run: client.WorkflowRunSlimV2{
+				Error: lo.ToPtr("workflow version not found"),
+			},
+			expected: "error",
+		},
+		{
+			name: "a step still to run",
+			run: client.WorkflowRunSlimV2{Progress: []client.StepProgressSlimV2{
+				step(client.StepProgressSlimV2StatusComplete),
+				step(client.StepProgressSlimV2StatusPending),
+			}},
+			expected: "pending",
+		},
+		{
+			name:     "no steps started yet",
+			run:      client.WorkflowRunSlimV2{},
+			expected: "pending",
+		},
+		{
+			name: "cancelled wins over everything",
+			run: client.WorkflowRunSlimV2{
+				CancelledAt: lo.ToPtr(time.Now()),
+				Progress:    []client.StepProgressSlimV2{step(client.StepProgressSlimV2StatusError)},
+			},
+			expected: "cancelled",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, workflowRunStatus(tc.run))
+		})
+	}
+}
+
+func TestNewestWorkflowRuns(t *testing.T) {
+	at := func(day int) time.Time {
+		return time.Date(2024, 5, day, 0, 0, 0, 0, time.UTC)
+	}
+	complete := []client.StepProgressSlimV2{{Status: client.StepProgressSlimV2StatusComplete}}
+	failed := []client.StepProgressSlimV2{{Status: client.StepProgressSlimV2StatusError}}
+
+	runs := []client.WorkflowRunSlimV2{
+		{Id: "01OLDEST", CreatedAt: at(1), Progress: failed},
+		{Id: "01NEWEST", CreatedAt: at(4), Progress: complete},
+		{Id: "01MIDDLE", CreatedAt: at(2), Progress: failed},
+		{Id: "01RECENT", CreatedAt: at(3), Progress: complete},
+	}
+
+	testCases := []struct {
+		name     string
+		status   types.String
+		limit    int
+		expected []string
+	}{
+		{
+			name:     "newest first",
+			status:   types.StringNull(),
+			limit:    10,
+			expected: []string{"01NEWEST", "01RECENT", "01MIDDLE", "01OLDEST"},
+		},
+		{
+			name:     "limit keeps the newest",
+			status:   types.StringNull(),
+			limit:    2,
+			expected: []string{"01NEWEST", "01RECENT"},
+		},
+		{
+			name:     "status filters before the limit",
+			status:   types.StringValue("error"),
+			limit:    1,
+			expected: []string{"01MIDDLE"},
+		},
+		{
+			name:     "no runs with the status",
+			status:   types.StringValue("cancelled"),
+			limit:    10,
+			expected: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := newestWorkflowRuns(runs, tc.status, tc.limit)
+			assert.Equal(t, tc.expected, lo.Map(result, func(run client.WorkflowRunSlimV2, _ int) string {
+				return run.Id
+			}))
+		})
+	}
+
+	assert.Equal(t, "01OLDEST", runs[0].Id, "sorting mustn't reorder the caller's runs")
+}
+
+func TestListWorkflowRuns(t *testing.T) {
+	// Three pages of two runs, newest first, alternating between failing and succeeding.
+	pages := []string{"", "01PAGE2", "01PAGE3"}
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page := 0
+		for idx, after := range pages {
+			if after == r.URL.Query().Get("after") {
+				page = idx
+			}
+		}
+
+		runs := []string{}
+		for idx := range 2 {
+			step := "complete"
+			if idx == 0 {
+				step = "error"
+			}
+			runs = append(runs, fmt.Sprintf(`{"id": "01RUN%d%d", "created_at": "2024-05-0%dT0%d:00:00Z", "progress": [{"status": %q}], "workflow_id": "01WORKFLOW", "workflow_name": "Example", "workflow_version_id": "01VERSION", "workflow_version_number": 1}`,
+				page, idx, 9-page, 9-idx, step))
+		}
+		after := "null"
+		if page+1 < len(pages) {
+			after = fmt.Sprintf("%q", pages[page+1])
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"workflow_runs": [%s, %s], "pagination_meta": {"after": %s, "page_size": 2}}`, runs[0], runs[1], after)
+	}))
+	t.Cleanup(server.Close)
+
+	api, err := client.New(t.Context(), "test-key", server.URL, "test")
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	testCases := []struct {
+		name             string
+		status           types.String
+		limit            int
+		expectedRuns     int
+		expectedRequests int
+	}{
+		{
+			name:             "stops once the limit is reached",
+			status:           types.StringNull(),
+			limit:            3,
+			expectedRuns:     4,
+			expectedRequests: 2,
+		},
+		{
+			name:             "counts only runs with the status",
+			status:           types.StringValue("error"),
+			limit:            2,
+			expectedRuns:     4,
+			expectedRequests: 2,
+		},
+		{
+			name:             "reads every page when there aren't enough runs",
+			status:           types.StringNull(),
+			limit:            10,
+			expectedRuns:     6,
+			expectedRequests: 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			requests = 0
+			runs, err := listWorkflowRuns(t.Context(), api, &client.WorkflowRunsV2ListParams{}, tc.status, tc.limit)
+			if err != nil {
+				t.Fatalf("listing runs: %v", err)
+			}
+
+			assert.Len(t, runs, tc.expectedRuns)
+			assert.Equal(t, tc.expectedRequests, requests)
+		})
+	}
+}
+
+func TestBuildWorkflowRunModel(t *testing.T) {
+	model := buildWorkflowRunModel(client.WorkflowRunSlimV2{
+		Id:                    "01RUN",
+		WorkflowId:            "01WORKFLOW",
+		WorkflowName:          lo.ToPtr("Page the on-call"),
+		WorkflowVersionNumber: 3,
+		IncidentId:            lo.ToPtr("01INCIDENT"),
+		CreatedAt:             time.Date(2024, 5, 1, 9, 30, 0, 0, time.UTC),
+		Progress: []client.StepProgressSlimV2{
+			{
+				Step:        "webhook.send",
+				Status:      client.StepProgressSlimV2StatusError,
+				Error:       lo.ToPtr("endpoint returned 503"),
+				CompletedAt: lo.ToPtr(time.Date(2024, 5, 1, 9, 30, 5, 0, time.UTC)),
+				WebhookDelivery: &client.WebhookDeliverySlimV2{
+					Outcome:    client.WebhookDeliverySlimV2OutcomeNon2xx,
+					StatusCode: lo.ToPtr(int64(503)),
+				},
+			},
+			{
+				Step:   "slack.post_message",
+				Status: client.StepProgressSlimV2StatusPending,
+			},
+		},
+	})
+
+	assert.Equal(t, "error", model.Status.ValueString())
+	assert.Equal(t, "2024-05-01T09:30:00Z", model.CreatedAt.ValueString())
+	assert.True(t, model.CancelledAt.IsNull())
+	assert.True(t, model.Error.IsNull())
+
+	assert.Len(t, model.Steps, 2)
+	assert.Equal(t, "endpoint returned 503", model.Steps[0].Error.ValueString())
+	assert.Equal(t, "2024-05-01T09:30:05Z", model.Steps[0].CompletedAt.ValueString())
+	assert.Equal(t, "non_2xx", model.Steps[0].WebhookOutcome.ValueString())
+	assert.Equal(t, int64(503), model.Steps[0].WebhookStatusCode.ValueInt64())
+	assert.True(t, model.Steps[1].CompletedAt.IsNull())
+	assert.True(t, model.Steps[1].WebhookOutcome.IsNull())
+	assert.True(t, model.Steps[1].WebhookStatusCode.IsNull())
+}
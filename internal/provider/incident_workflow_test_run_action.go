@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+)
+
+var (
+	_ action.Action              = &IncidentWorkflowTestRunAction{}
+	_ action.ActionWithConfigure = &IncidentWorkflowTestRunAction{}
+)
+
+func NewIncidentWorkflowTestRunAction() action.Action {
+	return &IncidentWorkflowTestRunAction{}
+}
+
+type IncidentWorkflowTestRunAction struct {
+	client *client.ClientWithResponses
+}
+
+type IncidentWorkflowTestRunActionModel struct {
+	IncidentID      types.String                         `tfsdk:"incident_id"`
+	ConditionGroups models.IncidentEngineConditionGroups `tfsdk:"condition_groups"`
+	Expressions     models.IncidentEngineExpressions     `tfsdk:"expressions"`
+	Steps           []IncidentWorkflowStep               `tfsdk:"steps"`
+	ExpectMatch     types.Bool                           `tfsdk:"expect_match"`
+}
+
+func (a *IncidentWorkflowTestRunAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_test_run"
+}
+
+func (a *IncidentWorkflowTestRunAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Dry-run a workflow's conditions and expressions against an existing incident, and
+report which conditions matched and what each step's params would resolve to. The steps
+themselves never run, and nothing is written to the incident.
+
+Pass the workflow's ` + "`condition_groups`, `expressions` and `steps`" + ` straight from an
+` + "`incident_workflow`" + `, then invoke the action with ` + "`terraform apply -invoke`" + `
+to see whether a change to the workflow would have fired on a past incident.
+
+A dry run only knows the incident's own fields: its name, summary, reference, mode,
+visibility, status, severity, incident type and custom fields. Conditions and params that
+reference anything else, or use operations only incident.io can evaluate, are reported as
+undetermined, with the reason.
+
+Actions need Terraform 1.14 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"incident_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the incident to test the workflow against.",
+				Required:            true,
+			},
+			"condition_groups": models.ConditionGroupsActionAttribute(),
+			"expressions":      models.ExpressionsActionAttribute(),
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The workflow's steps, whose params are resolved but never run.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"for_each": schema.StringAttribute{
+							Optional: true,
+						},
+						"id": schema.StringAttribute{
+							Optional: true,
+						},
+						"name": schema.StringAttribute{
+							Optional: true,
+						},
+						"param_bindings": models.ParamBindingsActionAttribute(),
+					},
+				},
+			},
+			"expect_match": schema.BoolAttribute{
+				MarkdownDescription: "If set, fail unless the conditions match the incident (`true`) or don't (`false`). " +
+					"A dry run that can't tell fails either way.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *IncidentWorkflowTestRunAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = data.Client
+}
+
+func (a *IncidentWorkflowTestRunAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data IncidentWorkflowTestRunActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := a.client.IncidentsV2ShowWithResponse(ctx, data.IncidentID.ValueString())
+	if err != nil {
+		addAPIError(ctx, &resp.Diagnostics, nil, "Client Error", "Unable to read incident", err)
+		return
+	}
+	if result.JSON200 == nil {
+		addAPIError(ctx, &resp.Diagnostics, nil, "Client Error", "Unable to read incident", fmt.Errorf("unexpected response: %s", result.Status()))
+		return
+	}
+
+	report, match := workflowTestRunReport(result.JSON200.Incident, data)
+	for _, line := range report {
+		resp.SendProgress(action.InvokeProgressEvent{Message: line})
+	}
+
+	if expected, ok := knownBool(data.ExpectMatch); ok {
+		switch {
+		case match == models.EngineUndetermined:
+			resp.Diagnostics.AddError(
+				"Workflow test run undetermined",
+				fmt.Sprintf("Couldn't tell whether the workflow's conditions match %s:\n\n%s", result.JSON200.Incident.Reference, strings.Join(report, "\n")),
+			)
+		case (match == models.EngineMatched) != expected:
+			resp.Diagnostics.AddError(
+				"Unexpected workflow test run",
+				fmt.Sprintf("Expected the workflow's conditions %s %s, but they %s:\n\n%s",
+					lo.Ternary(expected, "to match", "not to match"), result.JSON200.Incident.Reference,
+					lo.Ternary(expected, "don't", "do"), strings.Join(report, "\n")),
+			)
+		}
+	}
+}
+
+// workflowTestRunReport evaluates the workflow against the incident, one line per
+// condition, expression and param, ending with whether the workflow would run.
+func workflowTestRunReport(incident client.IncidentV2, data IncidentWorkflowTestRunActionModel) ([]string, models.EngineMatch) {
+	scope := incidentEngineScope(incident)
+	report := []string{fmt.Sprintf("Testing the workflow against %s: %s", incident.Reference, incident.Name)}
+
+	// Expressions first, as conditions and params can reference them.
+	for _, result := range data.Expressions.Evaluate(scope) {
+		if result.Err != nil {
+			report = append(report, fmt.Sprintf("Expression %q is undetermined: %s", result.Reference, result.Err))
+		} else {
+			report = append(report, fmt.Sprintf("Expression %q is %s", result.Reference, models.DescribeEngineValues(result.Values)))
+		}
+	}
+
+	match, conditions := data.ConditionGroups.Evaluate(scope)
+	for _, result := range conditions {
+		report = append(report, fmt.Sprintf("Condition group %d, condition %d (%s %s): %s, as %s",
+			result.Group+1, result.Condition+1, result.Subject, result.Operation, result.Match, result.Detail))
+	}
+
+	for idx, step := range data.Steps {
+		report = append(report, fmt.Sprintf("Step %d (%s):", idx+1, step.Name.ValueString()))
+		if forEach, ok := knownString(step.ForEach); ok {
+			values, err := scope.Resolve(fmt.Sprintf("expressions[%q]", forEach))
+			if err != nil {
+				report = append(report, fmt.Sprintf("  Runs for each result of %q, which is undetermined: %s", forEach, err))
+			} else {
+				report = append(report, fmt.Sprintf("  Runs for each of %s", models.DescribeEngineValues(values)))
+			}
+		}
+		for pi, binding := range step.ParamBindings {
+			switch values, err := binding.Resolve(scope); {
+			case err != nil:
+				report = append(report, fmt.Sprintf("  Param %d is undetermined: %s", pi+1, err))
+			case binding.IsEmpty():
+				report = append(report, fmt.Sprintf("  Param %d is skipped", pi+1))
+			default:
+				report = append(report, fmt.Sprintf("  Param %d is %s", pi+1, models.DescribeEngineValues(values)))
+			}
+		}
+	}
+
+	switch match {
+	case models.EngineMatched:
+		report = append(report, fmt.Sprintf("The workflow would run for %s.", incident.Reference))
+	case models.EngineNotMatched:
+		report = append(report, fmt.Sprintf("The workflow would not run for %s.", incident.Reference))
+	default:
+		report = append(report, fmt.Sprintf("Couldn't tell whether the workflow would run for %s.", incident.Reference))
+	}
+
+	return report, match
+}
+
+// incidentEngineScope spells the incident's fields as the engine references them, with
+// objects as their IDs, which is how a condition's literals refer to them.
+func incidentEngineScope(incident client.IncidentV2) models.EngineScope {
+	scope := models.EngineScope{
+		"incident":                 {incident.Id},
+		"incident.id":              {incident.Id},
+		"incident.name":            {incident.Name},
+		"incident.reference":       {incident.Reference},
+		"incident.mode":            {string(incident.Mode)},
+		"incident.visibility":      {string(incident.Visibility)},
+		"incident.status":          {incident.IncidentStatus.Id},
+		"incident.status.name":     {incident.IncidentStatus.Name},
+		"incident.status.category": {string(incident.IncidentStatus.Category)},
+		"incident.summary":         {},
+		"incident.severity":        {},
+		"incident.incident_type":   {},
+	}
+
+	if incident.Summary != nil && *incident.Summary != "" {
+		scope["incident.summary"] = []string{*incident.Summary}
+	}
+	if incident.Severity != nil {
+		scope["incident.severity"] = []string{incident.Severity.Id}
+		scope["incident.severity.name"] = []string{incident.Severity.Name}
+		scope["incident.severity.rank"] = []string{fmt.Sprint(incident.Severity.Rank)}
+	}
+	if incident.IncidentType != nil {
+		scope["incident.incident_type"] = []string{incident.IncidentType.Id}
+		scope["incident.incident_type.name"] = []string{incident.IncidentType.Name}
+	}
+
+	for _, entry := range incident.CustomFieldEntries {
+		values := []string{}
+		for _, value := range entry.Values {
+			switch {
+			case value.ValueOption != nil:
+				values = append(values, value.ValueOption.Id)
+			case value.ValueCatalogEntry != nil:
+				values = append(values, value.ValueCatalogEntry.Id)
+			case value.ValueText != nil:
+				values = append(values, *value.ValueText)
+			case value.ValueNumeric != nil:
+				values = append(values, *value.ValueNumeric)
+			case value.ValueLink != nil:
+				values = append(values, *value.ValueLink)
+			}
+		}
+		scope[fmt.Sprintf("incident.custom_field[%q]", entry.CustomField.Id)] = values
+	}
+
+	return scope
+}
@@ -0,0 +1,126 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+)
+
+func testRunIncident(category client.IncidentStatusV2Category) client.IncidentV2 {
+	return client.IncidentV2{
+		Id:        "01INCIDENT",
+		Name:      "Database is down",
+		Reference: "INC-123",
+		Mode:      client.IncidentV2ModeStandard,
+		IncidentStatus: client.IncidentStatusV2{
+			Id:       "01STATUS",
+			Name:     "Investigating",
+			Category: category,
+		},
+		Severity: &client.SeverityV2{Id: "01SEV1", Name: "Critical", Rank: 3},
+		CustomFieldEntries: []client.CustomFieldEntryV2{
+			{
+				CustomField: client.CustomFieldTypeInfoV2{Id: "01TEAM"},
+				Values: []client.CustomFieldValueV2{
+					{ValueOption: &client.CustomFieldOptionV2{Id: "01PAYMENTS"}},
+					{ValueOption: &client.CustomFieldOptionV2{Id: "01BILLING"}},
+				},
+			},
+			{
+				CustomField: client.CustomFieldTypeInfoV2{Id: "01NOTES"},
+				Values:      []client.CustomFieldValueV2{{ValueText: lo.ToPtr("Customer reported")}},
+			},
+		},
+	}
+}
+
+func TestIncidentEngineScope(t *testing.T) {
+	scope := incidentEngineScope(testRunIncident(client.IncidentStatusV2CategoryLive))
+
+	assert.Equal(t, []string{"01INCIDENT"}, scope["incident"])
+	assert.Equal(t, []string{"live"}, scope["incident.status.category"])
+	assert.Equal(t, []string{"01SEV1"}, scope["incident.severity"])
+	assert.Equal(t, []string{"3"}, scope["incident.severity.rank"])
+	assert.Equal(t, []string{"01PAYMENTS", "01BILLING"}, scope[`incident.custom_field["01TEAM"]`])
+	assert.Equal(t, []string{"Customer reported"}, scope[`incident.custom_field["01NOTES"]`])
+
+	// Unset fields are in scope, but empty, so is_set and is_blank can judge them.
+	values, err := scope.Resolve("incident.incident_type")
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestWorkflowTestRunReport(t *testing.T) {
+	workflow := v2WorkflowState()
+	data := IncidentWorkflowTestRunActionModel{
+		ConditionGroups: workflow.ConditionGroups,
+		Expressions:     workflow.Expressions,
+		Steps:           workflow.Steps,
+	}
+	data.ConditionGroups[0].Conditions[0].ParamBindings[0].ArrayValue[0] = *v2Literal("live")
+
+	t.Run("conditions matching the incident", func(t *testing.T) {
+		report, match := workflowTestRunReport(testRunIncident(client.IncidentStatusV2CategoryLive), data)
+
+		assert.Equal(t, models.EngineMatched, match)
+		assert.Equal(t, "Testing the workflow against INC-123: Database is down", report[0])
+		assert.Contains(t, report, `Condition group 1, condition 1 (incident.status.category one_of): matched, as incident.status.category is "live"`)
+		assert.Equal(t, "The workflow would run for INC-123.", report[len(report)-1])
+	})
+
+	t.Run("conditions not matching the incident", func(t *testing.T) {
+		_, match := workflowTestRunReport(testRunIncident(client.IncidentStatusV2CategoryClosed), data)
+
+		assert.Equal(t, models.EngineNotMatched, match)
+	})
+
+	t.Run("params are resolved but out-of-scope ones are reported", func(t *testing.T) {
+		report, _ := workflowTestRunReport(testRunIncident(client.IncidentStatusV2CategoryLive), data)
+
+		assert.Contains(t, report, `Expression "participants_cnt" is undetermined: incident.active_participants can't be resolved in a dry run`)
+		assert.Contains(t, report, "Step 1 (slack.post_message):")
+		assert.Contains(t, report, `  Param 1 is undetermined: the expression "participants_cnt" couldn't be evaluated`)
+		assert.Contains(t, report, "  Param 3 is skipped")
+		assert.Contains(t, report, `  Param 4 is "Write postmortem"`)
+	})
+}
+
+// TestWorkflowTestRunActionTakesAWorkflow checks the action's config decodes a workflow's
+// engine objects, as passed from an incident_workflow.
+func TestWorkflowTestRunActionTakesAWorkflow(t *testing.T) {
+	ctx := context.Background()
+	workflow := v2WorkflowState()
+
+	var schemaResp action.SchemaResponse
+	NewIncidentWorkflowTestRunAction().Schema(ctx, action.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, &IncidentWorkflowTestRunActionModel{
+		IncidentID:      types.StringValue("01INCIDENT"),
+		ConditionGroups: workflow.ConditionGroups,
+		Expressions:     workflow.Expressions,
+		Steps:           workflow.Steps,
+		ExpectMatch:     types.BoolNull(),
+	}); diags.HasError() {
+		t.Fatalf("building the config: %+v", diags)
+	}
+
+	var data IncidentWorkflowTestRunActionModel
+	if diags := (tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw}).Get(ctx, &data); diags.HasError() {
+		t.Fatalf("decoding the config: %+v", diags)
+	}
+
+	assert.Equal(t, workflow.ConditionGroups, data.ConditionGroups)
+	assert.Equal(t, workflow.Steps, data.Steps)
+}
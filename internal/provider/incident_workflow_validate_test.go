@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/provider/jsontypes"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/models"
+)
+
+// validateWorkflow writes the model into a config against the real schema, so ValidateConfig
+// decodes it exactly as it would Terraform's.
+func validateWorkflow(t *testing.T, model *IncidentWorkflowResourceModel) diag.Diagnostics {
+	t.Helper()
+
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewIncidentWorkflowResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("schema build failed: %+v", schemaResp.Diagnostics)
+	}
+
+	model.Timeouts = nullTimeouts(ctx)
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("building the config: %+v", diags)
+	}
+
+	resp := resource.ValidateConfigResponse{}
+	NewIncidentWorkflowResource().(*IncidentWorkflowResource).ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: state.Raw},
+	}, &resp)
+
+	return resp.Diagnostics
+}
+
+func assertErrorAt(t *testing.T, diags diag.Diagnostics, at path.Path, summary string) {
+	t.Helper()
+
+	for _, d := range diags.Errors() {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok && withPath.Path().Equal(at) && d.Summary() == summary {
+			return
+		}
+	}
+
+	t.Errorf("expected %q at %s, got %+v", summary, at, diags.Errors())
+}
+
+func TestIncidentWorkflowValidateConfigSteps(t *testing.T) {
+	step := path.Root("steps").AtListIndex(0)
+
+	t.Run("a valid workflow passes", func(t *testing.T) {
+		if diags := validateWorkflow(t, v2WorkflowState()); diags.HasError() {
+			t.Errorf("unexpected diagnostics: %+v", diags)
+		}
+	})
+
+	t.Run("a step ID that isn't a ULID", func(t *testing.T) {
+		model := v2WorkflowState()
+		model.Steps[0].ID = types.StringValue("post-message")
+
+		assertErrorAt(t, validateWorkflow(t, model), step.AtName("id"), "Invalid step ID")
+	})
+
+	t.Run("two steps with the same ID", func(t *testing.T) {
+		model := v2WorkflowState()
+		model.Steps = append(model.Steps, model.Steps[0])
+
+		assertErrorAt(t, validateWorkflow(t, model), path.Root("steps").AtListIndex(1).AtName("id"), "Duplicate step ID")
+	})
+
+	t.Run("for_each naming an unknown expression", func(t *testing.T) {
+		model := v2WorkflowState()
+		model.Steps[0].ForEach = types.StringValue("responders")
+
+		assertErrorAt(t, validateWorkflow(t, model), step.AtName("for_each"), "Unknown for_each")
+	})
+
+	t.Run("a param referencing an unknown expression", func(t *testing.T) {
+		model := v2WorkflowState()
+		model.Steps[0].ParamBindings[0].Value.Reference = types.StringValue(`expressions["participants"]`)
+
+		assertErrorAt(t, validateWorkflow(t, model),
+			step.AtName("param_bindings").AtListIndex(0).AtName("value").AtName("reference"), "Unknown expression")
+	})
+
+	t.Run("a param with both value and array_value", func(t *testing.T) {
+		model := v2WorkflowState()
+		model.Steps[0].ParamBindings[3].Value = &models.IncidentEngineParamBindingValue{
+			Literal:   jsontypes.NewNormalizedJSONOrStringValue("Write postmortem"),
+			Reference: types.StringNull(),
+		}
+
+		assertErrorAt(t, validateWorkflow(t, model), step.AtName("param_bindings").AtListIndex(3), "Ambiguous param binding")
+	})
+}
@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// listResourcePageSize is the page size used by list resources whose endpoints paginate,
+// which is within every such endpoint's limit.
+const listResourcePageSize = 25
+
+var (
+	_ list.ListResource              = &idListResource{}
+	_ list.ListResourceWithConfigure = &idListResource{}
+)
+
+// listedResource is one result of a list resource: the ID it imports by, and the name
+// `terraform query` shows for it.
+type listedResource struct {
+	ID   string
+	Name string
+}
+
+// idListResource lists every resource of one type in the organisation, for `terraform
+// query` to find what was set up in the dashboard and generate import blocks for it. Each
+// result's identity is the resource's ID. When Terraform asks for the resource's
+// attributes too, it's read just as an import would read it, without claiming it as
+// managed by Terraform.
+type idListResource struct {
+	resource resource.Resource
+	list     func(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error)
+	client   *client.ClientWithResponses
+}
+
+// importSeeder is implemented by a resource whose import does more than set its ID, such
+// as choosing between API versions, so that a listed resource reads as an imported one.
+type importSeeder interface {
+	seedImportState(ctx context.Context, id string, state *tfsdk.State, diags *diag.Diagnostics)
+}
+
+func newIDListResource(
+	newResource func() resource.Resource,
+	listResources func(ctx context.Context, apiClient *client.ClientWithResponses) ([]listedResource, error),
+) list.ListResource {
+	return &idListResource{resource: newResource(), list: listResources}
+}
+
+func (r *idListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	r.resource.Metadata(ctx, req, resp)
+}
+
+func (r *idListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists every `%s` in your organisation, identified by its ID.", r.typeName(ctx)),
+	}
+}
+
+func (r *idListResource) typeName(ctx context.Context) string {
+	var metadata resource.MetadataResponse
+	r.resource.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "incident"}, &metadata)
+
+	return metadata.TypeName
+}
+
+func (r *idListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*IncidentProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = data.Client
+
+	if configurable, ok := r.resource.(resource.ResourceWithConfigure); ok {
+		configurable.Configure(ctx, req, resp)
+	}
+}
+
+func (r *idListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	listed, err := r.list(ctx, r.client)
+	if err != nil {
+		var diags diag.Diagnostics
+		addAPIError(ctx, &diags, nil, "Client Error", fmt.Sprintf("Unable to list %s resources", r.typeName(ctx)), err)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for idx, item := range listed {
+			if req.Limit > 0 && int64(idx) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Name
+			result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root("id"), item.ID)...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				r.read(ctx, item.ID, &result)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+func (r *idListResource) read(ctx context.Context, id string, result *list.ListResult) {
+	state := tfsdk.State{
+		Schema: result.Resource.Schema,
+		Raw:    tftypes.NewValue(result.Resource.Schema.Type().TerraformType(ctx), nil),
+	}
+	if seeder, ok := r.resource.(importSeeder); ok {
+		seeder.seedImportState(ctx, id, &state, &result.Diagnostics)
+	} else {
+		result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+	}
+	if result.Diagnostics.HasError() {
+		return
+	}
+
+	resp := resource.ReadResponse{State: state, Identity: result.Identity}
+	r.resource.Read(ctx, resource.ReadRequest{State: state, Identity: result.Identity}, &resp)
+	result.Diagnostics.Append(resp.Diagnostics...)
+
+	result.Resource.Raw = resp.State.Raw
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+// fakeListAPI serves canned JSON by route, for list resources to page through.
+type fakeListAPI map[string]func(r *http.Request) string
+
+func (f fakeListAPI) start(t *testing.T) *client.ClientWithResponses {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	for pattern, body := range f {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body(r)))
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	api, err := client.New(t.Context(), "test-key", server.URL, "test")
+	if err != nil {
+		t.Fatalf("building client: %v", err)
+	}
+
+	return api
+}
+
+const testSeverityJSON = `{"id": "01SEV%[1]d", "name": "Sev %[1]d", "description": "", "rank": %[1]d,
+	"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}`
+
+func testSeverityAPI() fakeListAPI {
+	return fakeListAPI{
+		"GET /v1/severities": func(r *http.Request) string {
+			return `{"severities": [` + fmt.Sprintf(testSeverityJSON, 1) + `, ` + fmt.Sprintf(testSeverityJSON, 2) + `]}`
+		},
+		"GET /v1/severities/{id}": func(r *http.Request) string {
+			var rank int
+			_, _ = fmt.Sscanf(r.PathValue("id"), "01SEV%d", &rank)
+			return fmt.Sprintf(`{"severity": `+testSeverityJSON+`}`, rank)
+		},
+	}
+}
+
+// listResults runs a list resource as `terraform query` would, collecting its results.
+func listResults(t *testing.T, listResource list.ListResource, api *client.ClientWithResponses, includeResource bool, limit int64) []list.ListResult {
+	t.Helper()
+	ctx := context.Background()
+
+	var configureResp resource.ConfigureResponse
+	listResource.(list.ListResourceWithConfigure).Configure(ctx, resource.ConfigureRequest{
+		ProviderData: &IncidentProviderData{Client: api},
+	}, &configureResp)
+	if configureResp.Diagnostics.HasError() {
+		t.Fatalf("configuring: %+v", configureResp.Diagnostics)
+	}
+
+	res := listResource.(*idListResource).resource
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	res.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	var configSchemaResp list.ListResourceSchemaResponse
+	listResource.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchemaResp)
+
+	stream := list.ListResultsStream{}
+	listResource.List(ctx, list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchemaResp.Schema,
+			Raw:    tftypes.NewValue(configSchemaResp.Schema.Type().TerraformType(ctx), map[string]tftypes.Value{}),
+		},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: identityResp.IdentitySchema,
+	}, &stream)
+
+	results := []list.ListResult{}
+	for result := range stream.Results {
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func TestIDListResource(t *testing.T) {
+	api := testSeverityAPI().start(t)
+
+	t.Run("each result is identified by ID", func(t *testing.T) {
+		results := listResults(t, NewIncidentSeverityListResource(), api, false, 0)
+
+		if !assert.Len(t, results, 2) {
+			return
+		}
+		for idx, result := range results {
+			assert.False(t, result.Diagnostics.HasError(), "%+v", result.Diagnostics)
+			assert.Equal(t, fmt.Sprintf("Sev %d", idx+1), result.DisplayName)
+
+			var id types.String
+			result.Identity.GetAttribute(context.Background(), path.Root("id"), &id)
+			assert.Equal(t, fmt.Sprintf("01SEV%d", idx+1), id.ValueString())
+
+			// The resource is only read when Terraform asks for it.
+			assert.True(t, result.Resource.Raw.IsNull())
+		}
+	})
+
+	t.Run("the resource is read as an import would read it", func(t *testing.T) {
+		results := listResults(t, NewIncidentSeverityListResource(), api, true, 0)
+
+		if !assert.Len(t, results, 2) {
+			return
+		}
+		assert.False(t, results[1].Diagnostics.HasError(), "%+v", results[1].Diagnostics)
+
+		var data IncidentSeverityResourceModel
+		results[1].Resource.Get(context.Background(), &data)
+		assert.Equal(t, "01SEV2", data.ID.ValueString())
+		assert.Equal(t, "Sev 2", data.Name.ValueString())
+		assert.Equal(t, int64(2), data.Rank.ValueInt64())
+	})
+
+	t.Run("results stop at the limit", func(t *testing.T) {
+		results := listResults(t, NewIncidentSeverityListResource(), api, false, 1)
+
+		assert.Len(t, results, 1)
+	})
+
+	t.Run("a failed list is a diagnostic", func(t *testing.T) {
+		api := fakeListAPI{}.start(t)
+		results := listResults(t, NewIncidentSeverityListResource(), api, false, 0)
+
+		if !assert.Len(t, results, 1) {
+			return
+		}
+		assert.True(t, results[0].Diagnostics.HasError())
+		assert.Equal(t, "Client Error", results[0].Diagnostics[0].Summary())
+		assert.True(t, strings.HasPrefix(results[0].Diagnostics[0].Detail(), "Unable to list incident_severity resources, got error: "),
+			results[0].Diagnostics[0].Detail())
+	})
+}
+
+func TestListAlertRouteResources(t *testing.T) {
+	api := fakeListAPI{
+		"GET /v2/alert_routes": func(r *http.Request) string {
+			// Two pages, the first ending with a cursor for the second.
+			if r.URL.Query().Get("after") == "" {
+				return `{"alert_routes": [{"id": "01ROUTE1", "name": "Route 1", "enabled": true}],
+					"pagination_meta": {"page_size": 1, "after": "01ROUTE1"}}`
+			}
+			return `{"alert_routes": [{"id": "01ROUTE2", "name": "Route 2", "enabled": true}],
+				"pagination_meta": {"page_size": 1}}`
+		},
+	}.start(t)
+
+	listed, err := listAlertRouteResources(context.Background(), api)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []listedResource{
+		{ID: "01ROUTE1", Name: "Route 1"},
+		{ID: "01ROUTE2", Name: "Route 2"},
+	}, listed)
+}
+
+func TestListBuiltInResources(t *testing.T) {
+	api := fakeListAPI{
+		"GET /v1/incident_statuses": func(r *http.Request) string {
+			statuses := []string{}
+			for idx, category := range []string{"triage", "declined", "merged", "canceled", "live", "paused", "learning", "closed"} {
+				statuses = append(statuses, fmt.Sprintf(`{"id": "01STATUS%d", "name": %q, "category": %q, "description": "", "rank": %d,
+					"created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}`, idx, category, category, idx))
+			}
+			return `{"incident_statuses": [` + strings.Join(statuses, ", ") + `]}`
+		},
+		"GET /v2/incident_roles": func(r *http.Request) string {
+			roles := []string{}
+			for idx, roleType := range []string{"lead", "reporter", "custom"} {
+				roles = append(roles, fmt.Sprintf(`{"id": "01ROLE%d", "name": %q, "role_type": %q, "description": "", "instructions": "",
+					"shortform": "", "required": false, "created_at": "2024-01-01T00:00:00Z", "updated_at": "2024-01-01T00:00:00Z"}`, idx, roleType, roleType))
+			}
+			return `{"incident_roles": [` + strings.Join(roles, ", ") + `]}`
+		},
+	}.start(t)
+
+	t.Run("only statuses in categories that can be created", func(t *testing.T) {
+		listed, err := listStatusResources(context.Background(), api)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []listedResource{
+			{ID: "01STATUS4", Name: "live"},
+			{ID: "01STATUS6", Name: "learning"},
+			{ID: "01STATUS7", Name: "closed"},
+		}, listed)
+	})
+
+	t.Run("only custom roles", func(t *testing.T) {
+		listed, err := listRoleResources(context.Background(), api)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []listedResource{{ID: "01ROLE2", Name: "custom"}}, listed)
+	})
+}
+
+// TestListResourcesServe checks Terraform can be served every list resource, which needs
+// a resource of the same type with an identity.
+func TestListResourcesServe(t *testing.T) {
+	server, err := testAccProtoV6ProviderFactories["incident"]()
+	if err != nil {
+		t.Fatalf("building the provider server: %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("getting the provider schema: %v", err)
+	}
+	assert.Empty(t, resp.Diagnostics)
+	assert.Len(t, resp.ListResourceSchemas, len((&IncidentProvider{}).ListResources(context.Background())))
+
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("getting the identity schemas: %v", err)
+	}
+	assert.Empty(t, identities.Diagnostics)
+	for typeName := range resp.ListResourceSchemas {
+		assert.Contains(t, identities.IdentitySchemas, typeName)
+	}
+}
@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
@@ -26,7 +25,7 @@ func claimResource(
 
 	_, err := apiClient.ManagedResourcesV2CreateManagedResourceWithResponse(ctx, payload)
 	if err != nil {
-		diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create managed resource, got error: %s", err))
+		addAPIError(ctx, diagnostics, nil, "Client Error", "Unable to create managed resource", err)
 		return
 	}
 }
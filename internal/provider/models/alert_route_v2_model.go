@@ -3,6 +3,7 @@ package models
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -41,6 +42,7 @@ type AlertRouteResourceModel struct {
 	// incident_config.template. In v2 mode these are nil.
 	GroupingConfig *AlertRouteV3GroupingConfigModel `tfsdk:"grouping_config"`
 	MessageConfig  *AlertRouteV3MessageConfigModel  `tfsdk:"message_config"`
+	Timeouts       timeouts.Value                   `tfsdk:"timeouts"`
 }
 
 // IsV3Mode reports whether this configuration targets the v3 alert routes API.
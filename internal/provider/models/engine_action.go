@@ -0,0 +1,196 @@
+package models
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+
+	"github.com/incident-io/terraform-provider-incident/internal/apischema"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/jsontypes"
+)
+
+// Action attribute helpers (optional versions), for actions that take a workflow's
+// engine objects as config
+
+func ParamBindingValueActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"literal": schema.StringAttribute{
+			CustomType:          jsontypes.NormalizedJSONOrStringType{},
+			MarkdownDescription: apischema.Docstring("EngineParamBindingValueV2", "literal"),
+			Optional:            true,
+		},
+		"reference": schema.StringAttribute{
+			MarkdownDescription: apischema.Docstring("EngineParamBindingValueV2", "reference"),
+			Optional:            true,
+		},
+	}
+}
+
+func ParamBindingActionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"array_value": schema.ListNestedAttribute{
+			MarkdownDescription: apischema.Docstring("EngineParamBindingV2", "array_value"),
+			Optional:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: ParamBindingValueActionAttributes(),
+			},
+		},
+		"value": schema.SingleNestedAttribute{
+			MarkdownDescription: apischema.Docstring("EngineParamBindingV2", "value"),
+			Optional:            true,
+			Attributes:          ParamBindingValueActionAttributes(),
+		},
+	}
+}
+
+func ParamBindingsActionAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: apischema.Docstring("ConditionV2", "param_bindings"),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: ParamBindingActionAttributes(),
+		},
+	}
+}
+
+func ConditionsActionAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: apischema.Docstring("ConditionGroupV2", "conditions"),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"operation": schema.StringAttribute{
+					MarkdownDescription: apischema.Docstring("ConditionV2", "operation"),
+					Optional:            true,
+				},
+				"param_bindings": ParamBindingsActionAttribute(),
+				"subject": schema.StringAttribute{
+					MarkdownDescription: apischema.Docstring("ConditionV2", "subject"),
+					Optional:            true,
+				},
+			},
+		},
+	}
+}
+
+func ConditionGroupsActionAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		MarkdownDescription: apischema.Docstring("ExpressionFilterOptsV2", "condition_groups"),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"conditions": ConditionsActionAttribute(),
+			},
+		},
+	}
+}
+
+func ReturnsActionAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "returns"),
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"array": schema.BoolAttribute{
+				MarkdownDescription: apischema.Docstring("ReturnsMetaV2", "array"),
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: apischema.Docstring("ReturnsMetaV2", "type"),
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func ExpressionsActionAttribute() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: apischema.Docstring("WorkflowV2", "expressions"),
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"label": schema.StringAttribute{
+					MarkdownDescription: apischema.Docstring("ExpressionV2", "label"),
+					Optional:            true,
+				},
+				"reference": schema.StringAttribute{
+					MarkdownDescription: apischema.Docstring("ExpressionV2", "reference"),
+					Optional:            true,
+				},
+				"root_reference": schema.StringAttribute{
+					MarkdownDescription: apischema.Docstring("ExpressionV2", "root_reference"),
+					Optional:            true,
+				},
+				"else_branch": schema.SingleNestedAttribute{
+					MarkdownDescription: apischema.Docstring("ExpressionV2", "else_branch"),
+					Optional:            true,
+					Attributes: map[string]schema.Attribute{
+						"result": schema.SingleNestedAttribute{
+							MarkdownDescription: apischema.Docstring("ExpressionElseBranchV2", "result"),
+							Optional:            true,
+							Attributes:          ParamBindingActionAttributes(),
+						},
+					},
+				},
+				"operations": schema.ListNestedAttribute{
+					MarkdownDescription: apischema.Docstring("ExpressionV2", "operations"),
+					Optional:            true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"branches": schema.SingleNestedAttribute{
+								MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "branches"),
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"branches": schema.ListNestedAttribute{
+										MarkdownDescription: apischema.Docstring("ExpressionBranchesOptsV2", "branches"),
+										Optional:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"condition_groups": ConditionGroupsActionAttribute(),
+												"result": schema.SingleNestedAttribute{
+													MarkdownDescription: apischema.Docstring("ExpressionBranchV2", "result"),
+													Optional:            true,
+													Attributes:          ParamBindingActionAttributes(),
+												},
+											},
+										},
+									},
+									"returns": ReturnsActionAttribute(),
+								},
+							},
+							"filter": schema.SingleNestedAttribute{
+								MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "filter"),
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"condition_groups": ConditionGroupsActionAttribute(),
+								},
+							},
+							"navigate": schema.SingleNestedAttribute{
+								MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "navigate"),
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"reference": schema.StringAttribute{
+										MarkdownDescription: apischema.Docstring("ExpressionNavigateOptsV2", "reference"),
+										Optional:            true,
+									},
+								},
+							},
+							"operation_type": schema.StringAttribute{
+								MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "operation_type"),
+								Optional:            true,
+							},
+							"parse": schema.SingleNestedAttribute{
+								MarkdownDescription: apischema.Docstring("ExpressionOperationV2", "parse"),
+								Optional:            true,
+								Attributes: map[string]schema.Attribute{
+									"returns": ReturnsActionAttribute(),
+									"source": schema.StringAttribute{
+										MarkdownDescription: apischema.Docstring("ExpressionParseOptsV2", "source"),
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
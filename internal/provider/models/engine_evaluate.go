@@ -0,0 +1,297 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+// Dry runs of the V2 engine objects, for testing a workflow against an incident without
+// running its steps. Only the incident's own fields are in scope, and only the simpler
+// operations are evaluated: anything else comes out undetermined, with the reason, rather
+// than guessed at.
+
+// EngineScope maps each reference a dry run can resolve to its values, spelled as the
+// literals a param binding would compare them with: IDs for objects, and strings for
+// everything else.
+type EngineScope map[string][]string
+
+// Resolve fails for any reference that isn't in scope, including a path into an
+// expression's result such as `expressions["lead"].email`.
+func (scope EngineScope) Resolve(reference string) ([]string, error) {
+	if values, ok := scope[reference]; ok {
+		return values, nil
+	}
+
+	if name, tail, ok := splitExpressionReference(reference); ok && tail == "" {
+		return nil, fmt.Errorf("the expression %q couldn't be evaluated", name)
+	}
+
+	return nil, fmt.Errorf("%s can't be resolved in a dry run", reference)
+}
+
+// EngineMatch is the outcome of a condition, which is undetermined when the dry run can't
+// resolve its subject or evaluate its operation.
+type EngineMatch string
+
+const (
+	EngineMatched      EngineMatch = "matched"
+	EngineNotMatched   EngineMatch = "not matched"
+	EngineUndetermined EngineMatch = "undetermined"
+)
+
+// EngineConditionResult explains one condition's outcome: Detail says what the subject
+// resolved to, or why the condition is undetermined.
+type EngineConditionResult struct {
+	Group     int
+	Condition int
+	Subject   string
+	Operation string
+	Match     EngineMatch
+	Detail    string
+}
+
+// Evaluate matches when any group does, as the engine does. No groups at all always
+// matches.
+func (groups IncidentEngineConditionGroups) Evaluate(scope EngineScope) (EngineMatch, []EngineConditionResult) {
+	if len(groups) == 0 {
+		return EngineMatched, nil
+	}
+
+	results := []EngineConditionResult{}
+	match := EngineNotMatched
+	for gi, group := range groups {
+		groupMatch := EngineMatched
+		for ci, condition := range group.Conditions {
+			conditionMatch, detail := condition.Evaluate(scope)
+			results = append(results, EngineConditionResult{
+				Group:     gi,
+				Condition: ci,
+				Subject:   condition.Subject.ValueString(),
+				Operation: condition.Operation.ValueString(),
+				Match:     conditionMatch,
+				Detail:    detail,
+			})
+
+			switch {
+			case conditionMatch == EngineNotMatched:
+				groupMatch = EngineNotMatched
+			case conditionMatch == EngineUndetermined && groupMatch == EngineMatched:
+				groupMatch = EngineUndetermined
+			}
+		}
+
+		switch {
+		case groupMatch == EngineMatched:
+			match = EngineMatched
+		case groupMatch == EngineUndetermined && match == EngineNotMatched:
+			match = EngineUndetermined
+		}
+	}
+
+	return match, results
+}
+
+func (condition IncidentEngineCondition) Evaluate(scope EngineScope) (EngineMatch, string) {
+	subject, err := scope.Resolve(condition.Subject.ValueString())
+	if err != nil {
+		return EngineUndetermined, err.Error()
+	}
+
+	params := []string{}
+	for _, binding := range condition.ParamBindings {
+		values, err := binding.Resolve(scope)
+		if err != nil {
+			return EngineUndetermined, err.Error()
+		}
+		params = append(params, values...)
+	}
+
+	detail := fmt.Sprintf("%s is %s", condition.Subject.ValueString(), DescribeEngineValues(subject))
+
+	matched, err := evaluateOperation(condition.Operation.ValueString(), subject, params)
+	if err != nil {
+		return EngineUndetermined, fmt.Sprintf("%s, but %s", detail, err)
+	}
+	if matched {
+		return EngineMatched, detail
+	}
+
+	return EngineNotMatched, detail
+}
+
+func evaluateOperation(operation string, subject, params []string) (bool, error) {
+	switch operation {
+	case "is_set":
+		return len(subject) > 0, nil
+	case "is_blank":
+		return len(subject) == 0, nil
+	case "is", "one_of", "contains_one_of":
+		return lo.Some(subject, params), nil
+	case "is_not", "not_one_of":
+		return !lo.Some(subject, params), nil
+	case "all_of", "contains_all_of":
+		return lo.Every(subject, params), nil
+	case "contains":
+		return containsAny(subject, params), nil
+	case "does_not_contain":
+		return !containsAny(subject, params), nil
+	case "greater_than", "less_than":
+		if len(subject) != 1 || len(params) != 1 {
+			return false, fmt.Errorf("%s compares one number with another", operation)
+		}
+		left, leftErr := strconv.ParseFloat(subject[0], 64)
+		right, rightErr := strconv.ParseFloat(params[0], 64)
+		if leftErr != nil || rightErr != nil {
+			return false, fmt.Errorf("%s compares numbers, and only incident.io knows how to order these", operation)
+		}
+		if operation == "greater_than" {
+			return left > right, nil
+		}
+
+		return left < right, nil
+	default:
+		return false, fmt.Errorf("the %s operation can't be evaluated in a dry run", operation)
+	}
+}
+
+func containsAny(subject, params []string) bool {
+	return lo.SomeBy(subject, func(value string) bool {
+		return lo.SomeBy(params, func(param string) bool {
+			return strings.Contains(strings.ToLower(value), strings.ToLower(param))
+		})
+	})
+}
+
+// Resolve returns nothing for an empty binding, which skips an optional param.
+func (binding IncidentEngineParamBinding) Resolve(scope EngineScope) ([]string, error) {
+	if binding.Value != nil {
+		return binding.Value.Resolve(scope)
+	}
+
+	out := []string{}
+	for _, value := range binding.ArrayValue {
+		values, err := value.Resolve(scope)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, values...)
+	}
+
+	return out, nil
+}
+
+func (value IncidentEngineParamBindingValue) Resolve(scope EngineScope) ([]string, error) {
+	if !value.Literal.IsNull() && !value.Literal.IsUnknown() {
+		return []string{value.Literal.ValueString()}, nil
+	}
+	if !value.Reference.IsNull() && !value.Reference.IsUnknown() {
+		return scope.Resolve(value.Reference.ValueString())
+	}
+
+	return []string{}, nil
+}
+
+// EngineExpressionResult is an expression's result, or why it couldn't be evaluated.
+type EngineExpressionResult struct {
+	Reference string
+	Values    []string
+	Err       error
+}
+
+// Evaluate adds each expression's result to the scope, for the conditions and param
+// bindings that reference it. An expression can start from another, and a set holds them
+// in no particular order, so this keeps evaluating until a pass resolves nothing new.
+func (expressions IncidentEngineExpressions) Evaluate(scope EngineScope) []EngineExpressionResult {
+	results := make([]EngineExpressionResult, len(expressions))
+	done := make([]bool, len(expressions))
+
+	for progress := true; progress; {
+		progress = false
+		for idx, expression := range expressions {
+			if done[idx] {
+				continue
+			}
+
+			values, err := expression.Evaluate(scope)
+			results[idx] = EngineExpressionResult{
+				Reference: expression.Reference.ValueString(),
+				Values:    values,
+				Err:       err,
+			}
+			if err == nil {
+				scope[fmt.Sprintf("expressions[%q]", expression.Reference.ValueString())] = values
+				done[idx] = true
+				progress = true
+			}
+		}
+	}
+
+	return results
+}
+
+func (expression IncidentEngineExpression) Evaluate(scope EngineScope) ([]string, error) {
+	values, err := scope.Resolve(expression.RootReference.ValueString())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, operation := range expression.Operations {
+		switch operationType := operation.OperationType.ValueString(); operationType {
+		case "count":
+			values = []string{strconv.Itoa(len(values))}
+		case "first":
+			values = values[:min(1, len(values))]
+		case "branches":
+			if operation.Branches == nil {
+				return nil, errors.New("a branches operation needs its branches")
+			}
+			values, err = operation.Branches.Branches.Evaluate(scope, expression.ElseBranch)
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("the %s operation can't be evaluated in a dry run", operationType)
+		}
+	}
+
+	return values, nil
+}
+
+// Evaluate returns the result of the first branch that matches, or the else branch's if
+// none do. A branch that might match stops the evaluation, as the result would be a guess.
+func (branches IncidentEngineBranches) Evaluate(scope EngineScope, elseBranch *IncidentEngineElseBranch) ([]string, error) {
+	for idx, branch := range branches {
+		match, results := branch.ConditionGroups.Evaluate(scope)
+		switch match {
+		case EngineMatched:
+			return branch.Result.Resolve(scope)
+		case EngineUndetermined:
+			for _, result := range results {
+				if result.Match == EngineUndetermined {
+					return nil, fmt.Errorf("couldn't tell whether branch %d applies: %s", idx+1, result.Detail)
+				}
+			}
+		}
+	}
+
+	if elseBranch == nil {
+		return []string{}, nil
+	}
+
+	return elseBranch.Result.Resolve(scope)
+}
+
+// DescribeEngineValues spells resolved values out for a dry run's report.
+func DescribeEngineValues(values []string) string {
+	if len(values) == 0 {
+		return "not set"
+	}
+
+	return strings.Join(lo.Map(values, func(value string, _ int) string {
+		return strconv.Quote(value)
+	}), ", ")
+}
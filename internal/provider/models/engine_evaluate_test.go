@@ -0,0 +1,180 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/provider/jsontypes"
+)
+
+func literal(value string) IncidentEngineParamBindingValue {
+	return IncidentEngineParamBindingValue{
+		Literal:   jsontypes.NewNormalizedJSONOrStringValue(value),
+		Reference: types.StringNull(),
+	}
+}
+
+func reference(value string) IncidentEngineParamBindingValue {
+	return IncidentEngineParamBindingValue{
+		Literal:   jsontypes.NewNormalizedJSONOrStringNull(),
+		Reference: types.StringValue(value),
+	}
+}
+
+func condition(subject, operation string, values ...string) IncidentEngineCondition {
+	bindings := IncidentEngineParamBindings{}
+	if len(values) > 0 {
+		binding := IncidentEngineParamBinding{}
+		for _, value := range values {
+			binding.ArrayValue = append(binding.ArrayValue, literal(value))
+		}
+		bindings = append(bindings, binding)
+	}
+
+	return IncidentEngineCondition{
+		Subject:       types.StringValue(subject),
+		Operation:     types.StringValue(operation),
+		ParamBindings: bindings,
+	}
+}
+
+func testEngineScope() EngineScope {
+	return EngineScope{
+		"incident":                 {"01INCIDENT"},
+		"incident.name":            {"Database is down"},
+		"incident.status.category": {"live"},
+		"incident.severity":        {"01SEV1"},
+		"incident.severity.rank":   {"3"},
+		"incident.summary":         {},
+	}
+}
+
+func TestIncidentEngineCondition_Evaluate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		condition IncidentEngineCondition
+		expected  EngineMatch
+	}{
+		{"one_of matching", condition("incident.status.category", "one_of", "triage", "live"), EngineMatched},
+		{"one_of not matching", condition("incident.status.category", "one_of", "closed"), EngineNotMatched},
+		{"not_one_of", condition("incident.severity", "not_one_of", "01SEV2"), EngineMatched},
+		{"is_set", condition("incident.severity", "is_set"), EngineMatched},
+		{"is_blank on an empty field", condition("incident.summary", "is_blank"), EngineMatched},
+		{"contains ignores case", condition("incident.name", "contains", "DATABASE"), EngineMatched},
+		{"greater_than on numbers", condition("incident.severity.rank", "greater_than", "2"), EngineMatched},
+		{"greater_than on IDs", condition("incident.severity", "greater_than", "01SEV2"), EngineUndetermined},
+		{"a subject out of scope", condition("incident.active_participants", "is_set"), EngineUndetermined},
+		{"an operation the dry run can't do", condition("incident.name", "matches_regex", "^Data"), EngineUndetermined},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, detail := tc.condition.Evaluate(testEngineScope())
+			assert.Equal(t, tc.expected, match, detail)
+		})
+	}
+}
+
+func TestIncidentEngineConditionGroups_Evaluate(t *testing.T) {
+	matching := condition("incident.status.category", "one_of", "live")
+	failing := condition("incident.severity", "one_of", "01SEV2")
+	unknown := condition("incident.active_participants", "is_set")
+
+	testCases := []struct {
+		name     string
+		groups   IncidentEngineConditionGroups
+		expected EngineMatch
+	}{
+		{"no groups always match", IncidentEngineConditionGroups{}, EngineMatched},
+		{"every condition in a group must match", IncidentEngineConditionGroups{
+			{Conditions: IncidentEngineConditions{matching, failing}},
+		}, EngineNotMatched},
+		{"any group matching is enough", IncidentEngineConditionGroups{
+			{Conditions: IncidentEngineConditions{failing}},
+			{Conditions: IncidentEngineConditions{matching}},
+		}, EngineMatched},
+		{"a failing condition settles a group with an unknown one", IncidentEngineConditionGroups{
+			{Conditions: IncidentEngineConditions{unknown, failing}},
+		}, EngineNotMatched},
+		{"an unknown condition leaves the group undetermined", IncidentEngineConditionGroups{
+			{Conditions: IncidentEngineConditions{matching, unknown}},
+		}, EngineUndetermined},
+		{"a matching group settles an undetermined one", IncidentEngineConditionGroups{
+			{Conditions: IncidentEngineConditions{unknown}},
+			{Conditions: IncidentEngineConditions{matching}},
+		}, EngineMatched},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			match, results := tc.groups.Evaluate(testEngineScope())
+			assert.Equal(t, tc.expected, match, "%+v", results)
+		})
+	}
+}
+
+func TestIncidentEngineExpressions_Evaluate(t *testing.T) {
+	severityLabel := IncidentEngineExpression{
+		Reference:     types.StringValue("severity_label"),
+		RootReference: types.StringValue("incident.severity"),
+		Operations: IncidentEngineExpressionOperations{{
+			OperationType: types.StringValue("branches"),
+			Branches: &IncidentEngineExpressionBranchesOpts{
+				Branches: IncidentEngineBranches{{
+					ConditionGroups: IncidentEngineConditionGroups{{
+						Conditions: IncidentEngineConditions{condition("incident.severity", "one_of", "01SEV1")},
+					}},
+					Result: IncidentEngineParamBinding{Value: &IncidentEngineParamBindingValue{
+						Literal:   jsontypes.NewNormalizedJSONOrStringValue("critical"),
+						Reference: types.StringNull(),
+					}},
+				}},
+			},
+		}},
+		ElseBranch: &IncidentEngineElseBranch{Result: IncidentEngineParamBinding{
+			ArrayValue: []IncidentEngineParamBindingValue{literal("minor")},
+		}},
+	}
+	// Starts from the expression above, which a set may hold after it.
+	labelCount := IncidentEngineExpression{
+		Reference:     types.StringValue("label_count"),
+		RootReference: types.StringValue(`expressions["severity_label"]`),
+		Operations: IncidentEngineExpressionOperations{{
+			OperationType: types.StringValue("count"),
+		}},
+	}
+	participants := IncidentEngineExpression{
+		Reference:     types.StringValue("participants"),
+		RootReference: types.StringValue("incident.active_participants"),
+		Operations:    IncidentEngineExpressionOperations{},
+	}
+
+	scope := testEngineScope()
+	results := IncidentEngineExpressions{labelCount, participants, severityLabel}.Evaluate(scope)
+
+	assert.Equal(t, "label_count", results[0].Reference)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, []string{"1"}, results[0].Values)
+
+	assert.Error(t, results[1].Err)
+
+	assert.NoError(t, results[2].Err)
+	assert.Equal(t, []string{"critical"}, results[2].Values)
+	assert.Equal(t, []string{"critical"}, scope[`expressions["severity_label"]`])
+
+	t.Run("the else branch when no branch matches", func(t *testing.T) {
+		scope := testEngineScope()
+		scope["incident.severity"] = []string{"01SEV3"}
+
+		values, err := severityLabel.Evaluate(scope)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"minor"}, values)
+	})
+
+	t.Run("a reference to an expression that couldn't be evaluated", func(t *testing.T) {
+		_, err := reference(`expressions["participants"]`).Resolve(scope)
+		assert.ErrorContains(t, err, `the expression "participants" couldn't be evaluated`)
+	})
+}
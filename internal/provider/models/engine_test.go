@@ -4,7 +4,10 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -299,3 +302,74 @@ func TestIncidentEngineParamBinding_IsEmpty(t *testing.T) {
 		ArrayValue: []IncidentEngineParamBindingValue{{Reference: types.StringValue("incident")}},
 	}.IsEmpty(), "a populated array_value is not empty")
 }
+
+func TestIncidentEngineParamBindings_Validate(t *testing.T) {
+	literal := IncidentEngineParamBindingValue{Literal: jsontypes.NewNormalizedJSONOrStringValue("Write postmortem")}
+	ref := func(s string) IncidentEngineParamBindingValue {
+		return IncidentEngineParamBindingValue{Reference: types.StringValue(s)}
+	}
+	known := map[string]bool{"lead": true}
+
+	tests := []struct {
+		name     string
+		bindings IncidentEngineParamBindings
+		known    map[string]bool
+		// The attribute path of each expected error, in order.
+		want []string
+	}{
+		{
+			name:     "accepts_a_value_an_array_and_a_skipped_param",
+			bindings: IncidentEngineParamBindings{{Value: lo.ToPtr(ref("incident"))}, {ArrayValue: []IncidentEngineParamBindingValue{literal}}, {}},
+			known:    known,
+		},
+		{
+			name:     "rejects_value_alongside_array_value",
+			bindings: IncidentEngineParamBindings{{Value: &literal, ArrayValue: []IncidentEngineParamBindingValue{literal}}},
+			known:    known,
+			want:     []string{"param_bindings[0]"},
+		},
+		{
+			name:     "rejects_literal_alongside_reference",
+			bindings: IncidentEngineParamBindings{{}, {Value: &IncidentEngineParamBindingValue{Literal: literal.Literal, Reference: types.StringValue("incident")}}},
+			known:    known,
+			want:     []string{"param_bindings[1].value"},
+		},
+		{
+			name:     "rejects_an_empty_value",
+			bindings: IncidentEngineParamBindings{{ArrayValue: []IncidentEngineParamBindingValue{literal, {}}}},
+			known:    known,
+			want:     []string{"param_bindings[0].array_value[1]"},
+		},
+		{
+			name:     "accepts_a_path_into_a_known_expression",
+			bindings: IncidentEngineParamBindings{{Value: lo.ToPtr(ref(`expressions["lead"].email`))}},
+			known:    known,
+		},
+		{
+			name:     "rejects_an_unknown_expression",
+			bindings: IncidentEngineParamBindings{{Value: lo.ToPtr(ref(`expressions["leed"]`))}},
+			known:    known,
+			want:     []string{"param_bindings[0].value.reference"},
+		},
+		{
+			name:     "skips_expressions_that_are_not_known_yet",
+			bindings: IncidentEngineParamBindings{{Value: lo.ToPtr(ref(`expressions["leed"]`))}},
+			known:    nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			tc.bindings.Validate(path.Root("param_bindings"), tc.known, &diags)
+
+			got := []string{}
+			for _, d := range diags.Errors() {
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					got = append(got, withPath.Path().String())
+				}
+			}
+			assert.Equal(t, append([]string{}, tc.want...), got)
+		})
+	}
+}
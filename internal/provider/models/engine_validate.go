@@ -0,0 +1,74 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// Plan-time checks for the V2 engine objects. These cover only the shape of a binding:
+// which params a step takes, and of what type, depend on the step, and the API publishes
+// no catalogue of steps to check them against.
+
+// References returns the reference of every expression, for checking what a binding or a
+// step's for_each points at.
+func (expressions IncidentEngineExpressions) References() map[string]bool {
+	references := map[string]bool{}
+	for _, expression := range expressions {
+		references[expression.Reference.ValueString()] = true
+	}
+
+	return references
+}
+
+// Validate lets an empty binding through, as it skips an optional param. known is nil
+// when the expressions aren't known yet, which skips checking references to them.
+func (bindings IncidentEngineParamBindings) Validate(at path.Path, known map[string]bool, diags *diag.Diagnostics) {
+	for idx, binding := range bindings {
+		binding.Validate(at.AtListIndex(idx), known, diags)
+	}
+}
+
+func (binding IncidentEngineParamBinding) Validate(at path.Path, known map[string]bool, diags *diag.Diagnostics) {
+	if binding.Value != nil && len(binding.ArrayValue) > 0 {
+		diags.AddAttributeError(
+			at,
+			"Ambiguous param binding",
+			"Set either value, for a param taking a single value, or array_value, for one taking a list, not both.",
+		)
+	}
+
+	if binding.Value != nil {
+		binding.Value.Validate(at.AtName("value"), known, diags)
+	}
+
+	for idx, value := range binding.ArrayValue {
+		value.Validate(at.AtName("array_value").AtListIndex(idx), known, diags)
+	}
+}
+
+func (value IncidentEngineParamBindingValue) Validate(at path.Path, known map[string]bool, diags *diag.Diagnostics) {
+	switch {
+	case !value.Literal.IsNull() && !value.Reference.IsNull():
+		diags.AddAttributeError(at, "Ambiguous value", "Set either literal or reference, not both.")
+		return
+	case value.Literal.IsNull() && value.Reference.IsNull():
+		diags.AddAttributeError(at, "Missing value", "Set either literal or reference.")
+		return
+	}
+
+	if known == nil || value.Reference.IsUnknown() || value.Reference.IsNull() {
+		return
+	}
+
+	// A path into an expression's result, such as `expressions["lead"].email`, still needs
+	// the expression.
+	if name, _, ok := splitExpressionReference(value.Reference.ValueString()); ok && !known[name] {
+		diags.AddAttributeError(
+			at.AtName("reference"),
+			"Unknown expression",
+			fmt.Sprintf("No expression in this workflow has the reference %q.", name),
+		)
+	}
+}
@@ -144,7 +144,7 @@ func validateOperation(operation Operation, at path.Path, known map[string]bool,
 	}
 
 	if operation.Filter != nil {
-		validateConditions(operation.Filter.Conditions, operation.Filter.ConditionGroups, at.AtName("filter"), known, diags)
+		ValidateConditions(operation.Filter.Conditions, operation.Filter.ConditionGroups, at.AtName("filter"), known, diags)
 	}
 
 	if operation.Branches != nil {
@@ -225,7 +225,7 @@ func validateBranches(
 }
 
 func validateBranch(branch Branch, at path.Path, known map[string]bool, diags *diag.Diagnostics) {
-	validateConditions(branch.Conditions, branch.ConditionGroups, at, known, diags)
+	ValidateConditions(branch.Conditions, branch.ConditionGroups, at, known, diags)
 
 	if branch.Result == nil {
 		diags.AddAttributeError(
@@ -239,7 +239,9 @@ func validateBranch(branch Branch, at path.Path, known map[string]bool, diags *d
 	ValidateBinding(branch.Result, at.AtName("result"), known, diags)
 }
 
-func validateConditions(
+// ValidateConditions is exported for resources whose own conditions sit outside any
+// expression — a workflow's trigger conditions.
+func ValidateConditions(
 	conditions []Condition,
 	groups []ConditionGroup,
 	at path.Path,
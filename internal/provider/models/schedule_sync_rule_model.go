@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -9,12 +10,13 @@ import (
 
 // ScheduleSyncRuleResourceModel is the Terraform model for schedule sync rules.
 type ScheduleSyncRuleResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	ScheduleID             types.String `tfsdk:"schedule_id"`
-	ScheduleSyncTargetID   types.String `tfsdk:"schedule_sync_target_id"`
-	SyncType               types.String `tfsdk:"sync_type"`
-	RotationID             types.String `tfsdk:"rotation_id"`
-	PermanentMemberUserIDs types.Set    `tfsdk:"permanent_member_user_ids"`
+	ID                     types.String   `tfsdk:"id"`
+	ScheduleID             types.String   `tfsdk:"schedule_id"`
+	ScheduleSyncTargetID   types.String   `tfsdk:"schedule_sync_target_id"`
+	SyncType               types.String   `tfsdk:"sync_type"`
+	RotationID             types.String   `tfsdk:"rotation_id"`
+	PermanentMemberUserIDs types.Set      `tfsdk:"permanent_member_user_ids"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 // FromAPI converts an API response to the Terraform model.
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/incident-io/terraform-provider-incident/internal/client"
@@ -13,6 +14,7 @@ type ScheduleSyncTargetResourceModel struct {
 	SlackUserGroupID  types.String            `tfsdk:"slack_user_group_id"`
 	SlackTeamID       types.String            `tfsdk:"slack_team_id"`
 	NewSlackUserGroup *NewSlackUserGroupModel `tfsdk:"new_slack_user_group"`
+	Timeouts          timeouts.Value          `tfsdk:"timeouts"`
 }
 
 // NewSlackUserGroupModel represents the configuration for creating a new Slack user group.
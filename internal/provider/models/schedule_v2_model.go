@@ -1,6 +1,7 @@
 package models
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -11,6 +12,7 @@ type IncidentScheduleResourceModelV2 struct {
 	Rotations            []RotationV2            `tfsdk:"rotations"`
 	HolidaysPublicConfig *HolidaysPublicConfigV2 `tfsdk:"holidays_public_config"`
 	TeamIDs              types.Set               `tfsdk:"team_ids"`
+	Timeouts             timeouts.Value          `tfsdk:"timeouts"`
 }
 
 type RotationV2 struct {
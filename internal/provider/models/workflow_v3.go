@@ -0,0 +1,248 @@
+package models
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/richtexttypes"
+)
+
+// The V3 grammar for what only a workflow has: its trigger conditions and its steps' params.
+// Expressions are the same named_expression blocks every other V3 resource uses.
+//
+// The workflows API still speaks V2 payloads. Those serialise identically to the V3 ones, so
+// the resource converts at the edge and everything here works in V3 types.
+
+// StepParamBinding is one of a step's params. It takes every Binding spelling, plus rich_text
+// for the params holding a document — a Slack message, an incident update — which the V2
+// schema made you write as jsonencode'd AST.
+//
+// Unlike a Binding, an empty one means something: params are positional, so `{}` is how an
+// optional param ahead of a set one is skipped.
+type StepParamBinding struct {
+	ValueLiteral   types.String                `tfsdk:"value_literal"`
+	ValueReference types.String                `tfsdk:"value_reference"`
+	ExpressionRef  types.String                `tfsdk:"expression_ref"`
+	Values         []types.String              `tfsdk:"values"`
+	Value          *BindingValue               `tfsdk:"value"`
+	ArrayValue     []BindingValue              `tfsdk:"array_value"`
+	RichText       richtexttypes.TemplatedText `tfsdk:"rich_text"`
+}
+
+func (p StepParamBinding) binding() *Binding {
+	return &Binding{
+		ValueLiteral:   p.ValueLiteral,
+		ValueReference: p.ValueReference,
+		ExpressionRef:  p.ExpressionRef,
+		Values:         p.Values,
+		Value:          p.Value,
+		ArrayValue:     p.ArrayValue,
+	}
+}
+
+func stepParamBindingFromBinding(binding *Binding) StepParamBinding {
+	if binding == nil {
+		return StepParamBinding{RichText: richtexttypes.NewTemplatedTextNull()}
+	}
+
+	return StepParamBinding{
+		ValueLiteral:   binding.ValueLiteral,
+		ValueReference: binding.ValueReference,
+		ExpressionRef:  binding.ExpressionRef,
+		Values:         binding.Values,
+		Value:          binding.Value,
+		ArrayValue:     binding.ArrayValue,
+		RichText:       richtexttypes.NewTemplatedTextNull(),
+	}
+}
+
+func StepParamBindingAttributes() map[string]schema.Attribute {
+	attributes := BindingAttributes()
+	attributes["rich_text"] = schema.StringAttribute{
+		CustomType: richtexttypes.TemplatedTextType{},
+		Optional:   true,
+		Description: "Rich text, for a param that takes a message, which may interpolate the scope " +
+			"with `{{ variable }}`. For formatting a template can't express, pass a document " +
+			"from `data.incident_rich_text`.",
+	}
+
+	return attributes
+}
+
+// StepParamBindingToPayload never returns nil, because a skipped param still holds its place.
+func StepParamBindingToPayload(param StepParamBinding) (client.EngineParamBindingPayloadV3, error) {
+	if !param.RichText.IsNull() && !param.RichText.IsUnknown() {
+		if SetBindingForms(param.binding()) > 0 {
+			return client.EngineParamBindingPayloadV3{}, fmt.Errorf("set either rich_text or one of the other value forms, not both")
+		}
+
+		literal, err := param.RichText.Literal()
+		if err != nil {
+			return client.EngineParamBindingPayloadV3{}, err
+		}
+
+		return client.EngineParamBindingPayloadV3{
+			Value: &client.EngineParamBindingValuePayloadV3{Literal: literal},
+		}, nil
+	}
+
+	binding, err := BindingToPayload(param.binding())
+	if err != nil || binding == nil {
+		return client.EngineParamBindingPayloadV3{}, err
+	}
+
+	return *binding, nil
+}
+
+// ReconcileStepParamBinding keeps the spelling the config used whenever it still means what
+// the API returned, in the same way as ReconcileBinding. rich_text is compared semantically:
+// the API stores the document a template compiles to, never the template.
+func ReconcileStepParamBinding(
+	ctx context.Context,
+	prior *StepParamBinding,
+	fromAPI client.EngineParamBindingPayloadV3,
+) StepParamBinding {
+	if prior != nil {
+		if !prior.RichText.IsNull() {
+			if literal := singleLiteral(fromAPI); literal != nil {
+				equal, _ := prior.RichText.StringSemanticEquals(ctx, richtexttypes.NewTemplatedTextFromLiteral(*literal))
+				if equal {
+					return *prior
+				}
+			}
+		} else if want, err := StepParamBindingToPayload(*prior); err == nil && reflect.DeepEqual(want, fromAPI) {
+			return *prior
+		}
+	}
+
+	return StepParamBindingFromPayload(fromAPI)
+}
+
+// StepParamBindingFromPayload reads a literal holding a document as rich_text, but only where
+// it collapses to a template: a document that doesn't is just as exact as a value_literal, and
+// a value_literal is what an import of an ordinary JSON-valued param should produce.
+func StepParamBindingFromPayload(binding client.EngineParamBindingPayloadV3) StepParamBinding {
+	if literal := singleLiteral(binding); literal != nil {
+		if template, ok := richtexttypes.FromDocument([]byte(*literal)); ok {
+			param := stepParamBindingFromBinding(nil)
+			param.RichText = richtexttypes.NewTemplatedTextValue(template)
+
+			return param
+		}
+	}
+
+	return stepParamBindingFromBinding(BindingFromPayload(&binding))
+}
+
+func singleLiteral(binding client.EngineParamBindingPayloadV3) *string {
+	if binding.Value == nil || binding.Value.Literal == nil || binding.ArrayValue != nil && len(*binding.ArrayValue) > 0 {
+		return nil
+	}
+
+	return binding.Value.Literal
+}
+
+// ValidateStepParamBinding lets an empty param through, unlike ValidateBinding: it skips an
+// optional param rather than leaving a value out by mistake. Like ValidateBinding, it checks
+// the binding's shape and references, not the param it's bound to, which the API doesn't
+// describe.
+func ValidateStepParamBinding(param StepParamBinding, at path.Path, known map[string]bool, diags *diag.Diagnostics) {
+	forms := SetBindingForms(param.binding())
+
+	if !param.RichText.IsNull() {
+		if forms > 0 {
+			diags.AddAttributeError(
+				at,
+				"Ambiguous value",
+				"Set either rich_text or one of value_literal, value_reference, expression_ref, values, value or array_value.",
+			)
+		}
+
+		return
+	}
+
+	if forms > 0 {
+		ValidateBinding(param.binding(), at, known, diags)
+	}
+}
+
+// ReconcileConditions is ReconcileBinding for a resource's own conditions: the prior's
+// spelling survives whenever it still maps to what the API returned.
+func ReconcileConditions(
+	priorConditions []Condition,
+	priorGroups []ConditionGroup,
+	fromAPI []client.ConditionGroupPayloadV3,
+) ([]Condition, []ConditionGroup) {
+	if want, err := ConditionGroupsToPayload(priorConditions, priorGroups); err == nil {
+		RestorePlannedOperations(fromAPI, want)
+		if reflect.DeepEqual(want, fromAPI) {
+			return priorConditions, priorGroups
+		}
+	}
+
+	return conditionGroupsFromPayload(fromAPI)
+}
+
+// RestorePlannedOperations puts back the alias the config used wherever the API returned the
+// canonical name for it, as IncidentEngineConditionGroups.ReconcileOperations does for the V2
+// schema. Groups are lists, so they're correlated by position.
+func RestorePlannedOperations(applied, planned []client.ConditionGroupPayloadV3) {
+	for gi := range applied {
+		if gi >= len(planned) {
+			break
+		}
+		for ci := range applied[gi].Conditions {
+			if ci >= len(planned[gi].Conditions) {
+				break
+			}
+
+			want := planned[gi].Conditions[ci]
+			got := &applied[gi].Conditions[ci]
+			if got.Subject == want.Subject && serverOperationNormalisations[want.Operation] == got.Operation {
+				got.Operation = want.Operation
+			}
+		}
+	}
+}
+
+// RestorePlannedExpressionOperations does the same for the conditions inside expressions,
+// correlating expressions by reference.
+func RestorePlannedExpressionOperations(applied, planned []client.ExpressionPayloadV3) {
+	byReference := map[string]client.ExpressionPayloadV3{}
+	for _, expression := range planned {
+		byReference[expression.Reference] = expression
+	}
+
+	for ei := range applied {
+		want, ok := byReference[applied[ei].Reference]
+		if !ok {
+			continue
+		}
+
+		for oi := range applied[ei].Operations {
+			if oi >= len(want.Operations) {
+				break
+			}
+
+			got, wantOperation := applied[ei].Operations[oi], want.Operations[oi]
+			if got.Filter != nil && wantOperation.Filter != nil {
+				RestorePlannedOperations(got.Filter.ConditionGroups, wantOperation.Filter.ConditionGroups)
+			}
+			if got.Branches != nil && wantOperation.Branches != nil {
+				for bi := range got.Branches.Branches {
+					if bi >= len(wantOperation.Branches.Branches) {
+						break
+					}
+					RestorePlannedOperations(got.Branches.Branches[bi].ConditionGroups, wantOperation.Branches.Branches[bi].ConditionGroups)
+				}
+			}
+		}
+	}
+}
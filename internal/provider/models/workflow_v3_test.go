@@ -0,0 +1,144 @@
+package models
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/provider/richtexttypes"
+)
+
+func richTextParam(template string) StepParamBinding {
+	param := stepParamBindingFromBinding(nil)
+	param.RichText = richtexttypes.NewTemplatedTextValue(template)
+
+	return param
+}
+
+func literalParam(literal string) StepParamBinding {
+	param := stepParamBindingFromBinding(nil)
+	param.ValueLiteral = types.StringValue(literal)
+
+	return param
+}
+
+// TestStepParamBindingRoundTrip is what keeps a workflow's plan empty: each spelling a step
+// param can be written in must read back as itself, with no prior to lean on.
+func TestStepParamBindingRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		param StepParamBinding
+	}{
+		{"an empty param skipping its place", stepParamBindingFromBinding(nil)},
+		{"a plain literal", literalParam("01FCNDV6P870EA6S7TK1DSYDG0")},
+		{"rich text with a variable", richTextParam("{{incident.name}} needs you")},
+		{"an expression reference", func() StepParamBinding {
+			param := stepParamBindingFromBinding(nil)
+			param.ExpressionRef = types.StringValue("oncall")
+
+			return param
+		}()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			payload, err := StepParamBindingToPayload(tc.param)
+			if err != nil {
+				t.Fatalf("StepParamBindingToPayload: %v", err)
+			}
+
+			got := StepParamBindingFromPayload(payload)
+			again, err := StepParamBindingToPayload(got)
+			if err != nil {
+				t.Fatalf("StepParamBindingToPayload on the read value: %v", err)
+			}
+			if !reflect.DeepEqual(payload, again) {
+				t.Errorf("payload changed over a round trip: got %#v, want %#v", again, payload)
+			}
+			if tc.param.RichText.IsNull() != got.RichText.IsNull() {
+				t.Errorf("read back as a different form: got %#v, want %#v", got, tc.param)
+			}
+		})
+	}
+}
+
+func TestStepParamBindingRejectsTwoForms(t *testing.T) {
+	param := richTextParam("hello")
+	param.ValueLiteral = types.StringValue("hello")
+
+	if _, err := StepParamBindingToPayload(param); err == nil {
+		t.Error("expected an error for rich_text alongside value_literal")
+	}
+
+	var diags diag.Diagnostics
+	ValidateStepParamBinding(param, path.Root("param"), map[string]bool{}, &diags)
+	if !diags.HasError() {
+		t.Error("expected a diagnostic for rich_text alongside value_literal")
+	}
+}
+
+func TestValidateStepParamBindingAllowsEmpty(t *testing.T) {
+	var diags diag.Diagnostics
+	ValidateStepParamBinding(stepParamBindingFromBinding(nil), path.Root("param"), map[string]bool{}, &diags)
+	if diags.HasError() {
+		t.Errorf("unexpected diagnostics: %+v", diags)
+	}
+}
+
+func TestReconcileStepParamBinding(t *testing.T) {
+	ctx := context.Background()
+
+	// The API stores the document, so an equivalent template in other whitespace must survive.
+	prior := richTextParam("{{ incident.name }} needs you")
+	payload, err := StepParamBindingToPayload(richTextParam("{{incident.name}} needs you"))
+	if err != nil {
+		t.Fatalf("StepParamBindingToPayload: %v", err)
+	}
+
+	if got := ReconcileStepParamBinding(ctx, &prior, payload); !reflect.DeepEqual(got, prior) {
+		t.Errorf("prior template lost: got %#v", got)
+	}
+
+	changed, err := StepParamBindingToPayload(richTextParam("something else"))
+	if err != nil {
+		t.Fatalf("StepParamBindingToPayload: %v", err)
+	}
+	if got := ReconcileStepParamBinding(ctx, &prior, changed); reflect.DeepEqual(got, prior) {
+		t.Error("prior template kept over a changed value")
+	}
+
+	literal := literalParam("high")
+	literalPayload, err := StepParamBindingToPayload(literal)
+	if err != nil {
+		t.Fatalf("StepParamBindingToPayload: %v", err)
+	}
+	if got := ReconcileStepParamBinding(ctx, &literal, literalPayload); !reflect.DeepEqual(got, literal) {
+		t.Errorf("prior literal lost: got %#v", got)
+	}
+}
+
+// TestReconcileConditionsKeepsAlias covers the server renaming an operation on the way in: the
+// config's alias has to survive or every plan shows the rename.
+func TestReconcileConditionsKeepsAlias(t *testing.T) {
+	conditions := []Condition{{
+		Subject:   types.StringValue("incident.severity"),
+		Operation: types.StringValue("one_of"),
+		Params:    []Binding{{ValueLiteral: types.StringValue("01SEV")}},
+	}}
+
+	planned, err := ConditionGroupsToPayload(conditions, nil)
+	if err != nil {
+		t.Fatalf("ConditionGroupsToPayload: %v", err)
+	}
+
+	applied := []client.ConditionGroupPayloadV3{{Conditions: append([]client.ConditionPayloadV3{}, planned[0].Conditions...)}}
+	applied[0].Conditions[0].Operation = "contains_one_of"
+
+	gotConditions, gotGroups := ReconcileConditions(conditions, nil, applied)
+	if !reflect.DeepEqual(gotConditions, conditions) || gotGroups != nil {
+		t.Errorf("got %#v and %#v, want the prior conditions", gotConditions, gotGroups)
+	}
+}
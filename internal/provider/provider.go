@@ -4,19 +4,32 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"time"
 
 	_ "embed"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 )
 
-var _ provider.Provider = &IncidentProvider{}
+var (
+	_ provider.Provider                       = &IncidentProvider{}
+	_ provider.ProviderWithEphemeralResources = &IncidentProvider{}
+	_ provider.ProviderWithActions            = &IncidentProvider{}
+	_ provider.ProviderWithListResources      = &IncidentProvider{}
+)
 
 type IncidentProvider struct {
 	version string
@@ -25,11 +38,22 @@ type IncidentProvider struct {
 type IncidentProviderModel struct {
 	Endpoint types.String `tfsdk:"endpoint"`
 	APIKey   types.String `tfsdk:"api_key"`
+	ReadOnly types.Bool   `tfsdk:"read_only"`
+
+	ExpectedOrganisationID types.String `tfsdk:"expected_organisation_id"`
+
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	MinRetryWait      types.String  `tfsdk:"min_retry_wait"`
+	MaxRetryWait      types.String  `tfsdk:"max_retry_wait"`
+	RequestTimeout    types.String  `tfsdk:"request_timeout"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
 }
 
 type IncidentProviderData struct {
 	Client           *client.ClientWithResponses
 	TerraformVersion string
+
+	apiKeyTokens *issuedAPIKeyTokens
 }
 
 func New(version string) func() provider.Provider {
@@ -76,6 +100,34 @@ reproduce there. Pin to v5.x if you need to stay on an older CLI.
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse to make any change through the API, so Terraform can plan but never apply. Any create, update or delete fails before a request is sent, as does anything else that would change your account, such as creating a short-lived key with the `incident_api_key` ephemeral resource. Requests that only check or preview something, like validating an alert source, are still sent, so planning works as usual. Pair it with an API key that only has read access for a drift-detection job. Sourced from the `INCIDENT_READ_ONLY` environment variable, if set.",
+				Optional:            true,
+			},
+			"expected_organisation_id": schema.StringAttribute{
+				MarkdownDescription: "The organisation the API key must belong to, as its ID appears in its dashboard URL: `my-org` for `https://app.incident.io/my-org`. The provider checks the key when it's configured, and fails before planning anything if the key belongs to another organisation. Set it on each alias when you manage several organisations, so an alias given the wrong key can't apply one organisation's configuration to another. The `incident_identity` data source shows the organisation a key belongs to.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times to retry a request that was rate limited or failed with a server error. Defaults to 10.",
+				Optional:            true,
+			},
+			"min_retry_wait": schema.StringAttribute{
+				MarkdownDescription: "The shortest wait before retrying a request, as a duration such as `500ms` or `2s`. The wait doubles with each retry. Defaults to `1s`.",
+				Optional:            true,
+			},
+			"max_retry_wait": schema.StringAttribute{
+				MarkdownDescription: "The longest wait before retrying a request, as a duration such as `30s` or `1m`. A rate-limited request still waits as long as the API's `Retry-After` header asks. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long to wait for each attempt at a request before giving up on it and retrying, as a duration such as `30s`. Defaults to no limit, other than any `timeouts` on the resource.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The most requests to send each second, retries included, to keep a large apply under your API key's rate limit rather than backing off once it's hit. Defaults to no limit.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -104,7 +156,36 @@ func (p *IncidentProvider) Configure(ctx context.Context, req provider.Configure
 		apiKey = data.APIKey.ValueString()
 	}
 
-	c, err := client.New(ctx, apiKey, endpoint, p.version)
+	readOnly := false
+	if data.ReadOnly.IsNull() || data.ReadOnly.IsUnknown() {
+		if env := os.Getenv("INCIDENT_READ_ONLY"); env != "" {
+			var err error
+			readOnly, err = strconv.ParseBool(env)
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("read_only"),
+					"Invalid INCIDENT_READ_ONLY",
+					fmt.Sprintf("INCIDENT_READ_ONLY must be true or false, got %q.", env),
+				)
+				return
+			}
+		}
+	} else {
+		readOnly = data.ReadOnly.ValueBool()
+	}
+
+	opts := []client.ClientOption{}
+	if readOnly {
+		tflog.Info(ctx, "read-only mode is on: requests that would make a change won't be sent")
+		opts = append(opts, client.WithReadOnly())
+	}
+
+	policy := retryPolicy(data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	c, err := client.NewWithPolicy(ctx, apiKey, endpoint, p.version, policy, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create incident.io API Client",
@@ -113,19 +194,99 @@ func (p *IncidentProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	if !data.ExpectedOrganisationID.IsNull() && !data.ExpectedOrganisationID.IsUnknown() {
+		checkOrganisation(ctx, c, data.ExpectedOrganisationID.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Every kind of resource shares the tokens of API keys issued in this run, so the
+	// incident_api_key_token ephemeral resource can hand out what incident_api_key issued.
+	tokens := newIssuedAPIKeyTokens()
 	resp.DataSourceData = &IncidentProviderData{
 		Client:           c,
 		TerraformVersion: req.TerraformVersion,
+		apiKeyTokens:     tokens,
 	}
 	resp.ResourceData = &IncidentProviderData{
 		Client:           c,
 		TerraformVersion: req.TerraformVersion,
+		apiKeyTokens:     tokens,
+	}
+	resp.EphemeralResourceData = &IncidentProviderData{
+		Client:           c,
+		TerraformVersion: req.TerraformVersion,
+		apiKeyTokens:     tokens,
+	}
+	resp.ActionData = &IncidentProviderData{
+		Client:           c,
+		TerraformVersion: req.TerraformVersion,
+		apiKeyTokens:     tokens,
+	}
+	resp.ListResourceData = &IncidentProviderData{
+		Client:           c,
+		TerraformVersion: req.TerraformVersion,
+		apiKeyTokens:     tokens,
+	}
+}
+
+// retryPolicy is the client's default policy, with any of it that the provider config
+// overrides.
+func retryPolicy(data IncidentProviderModel, diags *diag.Diagnostics) client.Policy {
+	policy := client.DefaultPolicy()
+
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		if data.MaxRetries.ValueInt64() < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries can't be negative.")
+		}
+		policy.MaxRetries = int(data.MaxRetries.ValueInt64())
 	}
+
+	duration := func(attr string, value types.String, into *time.Duration) {
+		if value.IsNull() || value.IsUnknown() {
+			return
+		}
+		parsed, err := time.ParseDuration(value.ValueString())
+		if err == nil && parsed < 0 {
+			err = fmt.Errorf("it can't be negative")
+		}
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(attr),
+				fmt.Sprintf("Invalid %s", attr),
+				fmt.Sprintf("%s must be a duration such as \"30s\", got %q: %s.", attr, value.ValueString(), err),
+			)
+			return
+		}
+		*into = parsed
+	}
+	duration("min_retry_wait", data.MinRetryWait, &policy.MinBackoff)
+	duration("max_retry_wait", data.MaxRetryWait, &policy.MaxBackoff)
+	duration("request_timeout", data.RequestTimeout, &policy.RequestTimeout)
+
+	if policy.MaxBackoff < policy.MinBackoff {
+		diags.AddAttributeError(
+			path.Root("max_retry_wait"),
+			"Invalid max_retry_wait",
+			fmt.Sprintf("max_retry_wait (%s) can't be shorter than min_retry_wait (%s).", policy.MaxBackoff, policy.MinBackoff),
+		)
+	}
+
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
+		if data.RequestsPerSecond.ValueFloat64() < 0 {
+			diags.AddAttributeError(path.Root("requests_per_second"), "Invalid requests_per_second", "requests_per_second can't be negative.")
+		}
+		policy.RequestsPerSecond = data.RequestsPerSecond.ValueFloat64()
+	}
+
+	return policy
 }
 
 func (p *IncidentProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewIncidentAlertSourceResource,
+		NewIncidentAPIKeyResource,
 		NewIncidentCatalogEntriesResource,
 		NewIncidentCatalogEntryResource,
 		NewIncidentCatalogTypeAttributesResource,
@@ -133,18 +294,26 @@ func (p *IncidentProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewIncidentCustomFieldOptionResource,
 		NewIncidentCustomFieldResource,
 		NewIncidentEscalationPathResource,
+		NewIncidentIPAllowlistResource,
 		NewIncidentRoleResource,
+		NewIncidentSecretResource,
 		NewIncidentSeverityResource,
 		NewIncidentStatusResource,
 		NewIncidentScheduleResource,
 		NewIncidentScheduleBetaResource,
 		NewIncidentScheduleRotationBetaResource,
+		NewIncidentScheduleOverrideResource,
+		NewIncidentScheduleReplicaResource,
 		NewIncidentScheduleSyncTargetResource,
 		NewIncidentScheduleSyncRuleResource,
 		NewIncidentWorkflowResource,
+		NewIncidentWorkflowBetaResource,
 		NewIncidentAlertAttributeResource,
 		NewIncidentAlertRouteResource,
 		NewIncidentMaintenanceWindowResource,
+		NewIncidentStatusPageMaintenanceResource,
+		NewIncidentUserPagingProviderResource,
+		NewIncidentUserNotificationRuleResource,
 		NewAlertSourceBetaResource,
 		NewAlertSourceAttributeBetaResource,
 	}
@@ -165,8 +334,72 @@ func (p *IncidentProvider) DataSources(ctx context.Context) []func() datasource.
 		NewIncidentScheduleDataSource,
 		NewIncidentScheduleBetaDataSource,
 		NewIncidentScheduleRotationBetaDataSource,
+		NewIncidentScheduleOverridesDataSource,
+		NewIncidentScheduleEntriesDataSource,
 		NewIncidentIncidentTypesDataSource,
+		NewIncidentSeverityDataSource,
+		NewIncidentSeveritiesDataSource,
+		NewIncidentStatusDataSource,
+		NewIncidentStatusesDataSource,
+		NewIncidentTeamDataSource,
+		NewIncidentTeamsDataSource,
+		NewIncidentStatusPageDataSource,
+		NewIncidentStatusPageStructureDataSource,
+		NewIncidentUsersDataSource,
+		NewIncidentWorkflowRunsDataSource,
 		NewIncidentEscalationPathDataSource,
+		NewIncidentIdentityDataSource,
 		NewRichTextDataSource,
 	}
 }
+
+func (p *IncidentProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewIncidentAPIKeyEphemeralResource,
+		NewIncidentAPIKeyTokenEphemeralResource,
+	}
+}
+
+func (p *IncidentProvider) Actions(ctx context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewIncidentWorkflowTestRunAction,
+	}
+}
+
+func (p *IncidentProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewIncidentAlertRouteListResource,
+		NewIncidentAlertSourceListResource,
+		NewIncidentCatalogTypeListResource,
+		NewIncidentCustomFieldListResource,
+		NewIncidentEscalationPathListResource,
+		NewIncidentRoleListResource,
+		NewIncidentScheduleListResource,
+		NewIncidentSeverityListResource,
+		NewIncidentStatusListResource,
+		NewIncidentWorkflowListResource,
+	}
+}
+
+// checkOrganisation fails unless the client's API key belongs to the expected
+// organisation, so an alias given the wrong key can't change another organisation.
+func checkOrganisation(ctx context.Context, apiClient *client.ClientWithResponses, expected string, diags *diag.Diagnostics) {
+	result, err := apiClient.UtilitiesV1IdentityWithResponse(ctx)
+	if err != nil {
+		addAPIError(ctx, diags, nil, "Client Error", "Unable to check the API key's organisation", err)
+		return
+	}
+
+	actual := organisationID(result.JSON200.Identity)
+	if actual != expected {
+		diags.AddAttributeError(
+			path.Root("expected_organisation_id"),
+			"Wrong organisation",
+			fmt.Sprintf("The API key belongs to the %q organisation (%s), but expected_organisation_id is %q. Check this provider is configured with the right API key.",
+				actual, result.JSON200.Identity.DashboardUrl, expected),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("the API key belongs to the expected organisation, %s", expected))
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+)
+
+func TestProviderReadOnly(t *testing.T) {
+	// Any request that reaches the API is a failure of read-only mode.
+	requests := []string{}
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	openAPIKey := func(t *testing.T, providerServer tfprotov6.ProviderServer) []*tfprotov6.Diagnostic {
+		_, keyConfig := testAPIKeyEphemeralConfig()
+		resp, err := providerServer.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+			TypeName: "incident_api_key",
+			Config:   keyConfig,
+		})
+		if err != nil {
+			t.Fatalf("opening the ephemeral resource: %v", err)
+		}
+
+		return resp.Diagnostics
+	}
+
+	t.Run("read_only refuses changes before they're sent", func(t *testing.T) {
+		requests = nil
+		providerServer, diags := testProviderServer(t, api, map[string]tftypes.Value{
+			"read_only": tftypes.NewValue(tftypes.Bool, true),
+		})
+		if len(diags) > 0 {
+			t.Fatalf("configuring the provider: %+v", diags)
+		}
+
+		diags = openAPIKey(t, providerServer)
+
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, tfprotov6.DiagnosticSeverityError, diags[0].Severity)
+			assert.Equal(t, "Refused by read-only mode", diags[0].Summary)
+			assert.Contains(t, diags[0].Detail, "the provider refused to create API key 'CI': the POST /v1/api_keys request that would make this change wasn't sent")
+		}
+		assert.Empty(t, requests)
+	})
+
+	t.Run("INCIDENT_READ_ONLY turns it on", func(t *testing.T) {
+		requests = nil
+		t.Setenv("INCIDENT_READ_ONLY", "true")
+		providerServer, diags := testProviderServer(t, api, nil)
+		if len(diags) > 0 {
+			t.Fatalf("configuring the provider: %+v", diags)
+		}
+
+		diags = openAPIKey(t, providerServer)
+
+		assert.NotEmpty(t, diags)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("read_only in config wins over INCIDENT_READ_ONLY", func(t *testing.T) {
+		requests = nil
+		t.Setenv("INCIDENT_READ_ONLY", "true")
+		providerServer, diags := testProviderServer(t, api, map[string]tftypes.Value{
+			"read_only": tftypes.NewValue(tftypes.Bool, false),
+		})
+		if len(diags) > 0 {
+			t.Fatalf("configuring the provider: %+v", diags)
+		}
+
+		openAPIKey(t, providerServer)
+
+		assert.Equal(t, []string{"POST /v1/api_keys"}, requests)
+	})
+
+	t.Run("an invalid INCIDENT_READ_ONLY is an error", func(t *testing.T) {
+		t.Setenv("INCIDENT_READ_ONLY", "sometimes")
+		_, diags := testProviderServer(t, api, nil)
+
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "Invalid INCIDENT_READ_ONLY", diags[0].Summary)
+		}
+	})
+
+	t.Run("actions that don't change anything are still sent", func(t *testing.T) {
+		requests = nil
+		server := httptest.NewServer(api)
+		t.Cleanup(server.Close)
+		readOnlyClient, err := client.New(context.Background(), "test-key", server.URL, "test", client.WithReadOnly())
+		if err != nil {
+			t.Fatalf("building client: %v", err)
+		}
+
+		// Alert sources are validated like this at plan time.
+		_, _ = readOnlyClient.AlertSourcesV3ValidateWithResponse(context.Background(), client.AlertSourcesV3ValidateJSONRequestBody{})
+
+		assert.Equal(t, []string{"POST /v3/alert_sources/actions/validate"}, requests)
+	})
+}
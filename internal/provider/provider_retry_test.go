@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProviderRetryPolicy(t *testing.T) {
+	attempts := 0
+	api := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	t.Run("retries as many times as max_retries allows", func(t *testing.T) {
+		attempts = 0
+		providerServer, diags := testProviderServer(t, api, map[string]tftypes.Value{
+			"max_retries":    tftypes.NewValue(tftypes.Number, 2),
+			"min_retry_wait": tftypes.NewValue(tftypes.String, "1ms"),
+			"max_retry_wait": tftypes.NewValue(tftypes.String, "1ms"),
+		})
+		if len(diags) > 0 {
+			t.Fatalf("configuring the provider: %+v", diags)
+		}
+
+		_, keyConfig := testAPIKeyEphemeralConfig()
+		resp, err := providerServer.OpenEphemeralResource(context.Background(), &tfprotov6.OpenEphemeralResourceRequest{
+			TypeName: "incident_api_key",
+			Config:   keyConfig,
+		})
+		if err != nil {
+			t.Fatalf("opening the ephemeral resource: %v", err)
+		}
+
+		assert.Len(t, resp.Diagnostics, 1)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("rejects a wait that isn't a duration", func(t *testing.T) {
+		_, diags := testProviderServer(t, api, map[string]tftypes.Value{
+			"request_timeout": tftypes.NewValue(tftypes.String, "30"),
+		})
+
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "Invalid request_timeout", diags[0].Summary)
+			assert.Equal(t, tftypes.NewAttributePath().WithAttributeName("request_timeout"), diags[0].Attribute)
+		}
+	})
+
+	t.Run("rejects a maximum wait shorter than the minimum", func(t *testing.T) {
+		_, diags := testProviderServer(t, api, map[string]tftypes.Value{
+			"min_retry_wait": tftypes.NewValue(tftypes.String, "10s"),
+			"max_retry_wait": tftypes.NewValue(tftypes.String, "5s"),
+		})
+
+		if assert.Len(t, diags, 1) {
+			assert.Equal(t, "Invalid max_retry_wait", diags[0].Summary)
+		}
+	})
+}
@@ -4,19 +4,53 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"text/template"
+	"time"
 
 	"github.com/Masterminds/sprig"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/incident-io/terraform-provider-incident/internal/client"
 )
 
-var testRunID = uuid.NewString()
+// testRunID names the resources this test run creates. Each run picks its own, so that
+// runs against the shared test org don't collide, whether they're recording or not. A
+// replay names them with the ID its cassette was recorded with instead, so that it sends
+// the requests it recorded. That's read before any test starts, so a replaying process
+// can only replay one cassette: see testAccCassette.
+var testRunID, testRunIDErr = func() (string, error) {
+	switch os.Getenv(client.RecordingEnv) {
+	case "record":
+		runID := uuid.NewString()
+		// Saved with each cassette, for replaying it.
+		if err := os.Setenv(client.RunIDEnv, runID); err != nil {
+			return runID, err
+		}
+
+		return runID, nil
+	case "replay":
+		runID, err := client.CassetteRunID()
+		if err == nil && runID == "" {
+			err = fmt.Errorf("%s doesn't name a cassette to replay", client.CassetteEnv)
+		}
+		if err != nil {
+			// Stands in until testAccCassette fails the test.
+			return "00000000-0000-4000-8000-000000000000", err
+		}
+
+		return runID, nil
+	}
+
+	return uuid.NewString(), nil
+}()
 
 // testRunShortID keeps concurrent runs apart while costing as few characters as
 // possible. Custom fields cap names at 50, and several test names compose: a route
@@ -64,7 +98,100 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"incident": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// testProviderServer serves the provider configured against a fake API, for tests that
+// go through Terraform's protocol without running Terraform. Provider attributes left
+// out of config are null. It returns any diagnostics from configuring the provider.
+func testProviderServer(t *testing.T, api http.Handler, config map[string]tftypes.Value) (tfprotov6.ProviderServer, []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+	t.Setenv("INCIDENT_ENDPOINT", server.URL)
+
+	providerServer, err := testAccProtoV6ProviderFactories["incident"]()
+	if err != nil {
+		t.Fatalf("building the provider server: %v", err)
+	}
+
+	providerType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"endpoint":                 tftypes.String,
+		"api_key":                  tftypes.String,
+		"read_only":                tftypes.Bool,
+		"expected_organisation_id": tftypes.String,
+		"max_retries":              tftypes.Number,
+		"min_retry_wait":           tftypes.String,
+		"max_retry_wait":           tftypes.String,
+		"request_timeout":          tftypes.String,
+		"requests_per_second":      tftypes.Number,
+	}}
+	values := map[string]tftypes.Value{"api_key": tftypes.NewValue(tftypes.String, "test-key")}
+	for name, value := range config {
+		values[name] = value
+	}
+	for name, attrType := range providerType.AttributeTypes {
+		if _, ok := values[name]; !ok {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	providerConfig, err := tfprotov6.NewDynamicValue(providerType, tftypes.NewValue(providerType, values))
+	if err != nil {
+		t.Fatalf("building the provider config: %v", err)
+	}
+	resp, err := providerServer.ConfigureProvider(context.Background(), &tfprotov6.ConfigureProviderRequest{Config: &providerConfig})
+	if err != nil {
+		t.Fatalf("configuring the provider: %v", err)
+	}
+
+	return providerServer, resp.Diagnostics
+}
+
+// testAccCassette points the client at this test's cassette, if it's recording or
+// replaying. A replay fails unless this process named its resources as the cassette's
+// run did, which only happens when it replays just this test: use `make testacc-replay`.
+func testAccCassette(t *testing.T) {
+	if os.Getenv(client.RecordingEnv) == "" {
+		return
+	}
+	t.Setenv(client.CassetteEnv, filepath.Join("testdata", "cassettes", t.Name()+".json"))
+
+	if os.Getenv(client.RecordingEnv) != "replay" {
+		return
+	}
+	if testRunIDErr != nil {
+		t.Fatalf("Error reading which run recorded the cassette, replay tests with make testacc-replay: %s", testRunIDErr)
+	}
+	runID, err := client.CassetteRunID()
+	if err != nil {
+		t.Fatalf("Error reading which run recorded the cassette: %s", err)
+	}
+	if runID != testRunID {
+		t.Fatalf("Cassette was recorded by run %s, but this process replays run %s: replay each test on its own with make testacc-replay", runID, testRunID)
+	}
+}
+
+// testAccNow is the time a test should work out the times in its config from, rather
+// than time.Now(): while replaying, it's when the test was recorded, so the test sends the
+// requests it recorded.
+func testAccNow(t *testing.T) time.Time {
+	testAccCassette(t)
+
+	now, err := client.CassetteNow()
+	if err != nil {
+		t.Fatalf("Error reading when the cassette was recorded: %s", err)
+	}
+
+	return now
+}
+
 func testAccPreCheck(t *testing.T) {
+	// Each test records to, or replays from, its own cassette. A replayed test sends no
+	// requests, so needs no API key.
+	testAccCassette(t)
+	if os.Getenv(client.RecordingEnv) == "replay" && os.Getenv("INCIDENT_API_KEY") == "" {
+		t.Setenv("INCIDENT_API_KEY", "replay")
+	}
+
 	if os.Getenv("INCIDENT_API_KEY") == "" {
 		t.Skip("No INCIDENT_API_KEY environment variable set, skipping")
 	} else {
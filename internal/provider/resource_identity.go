@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// idIdentitySchema is the identity of a resource that imports by its ID alone, which is
+// most of them. Terraform 1.12 and later can then import it with an `identity` block,
+// and list it with `terraform query`.
+func idIdentitySchema() identityschema.Schema {
+	return identitySchema(map[string]string{
+		"id": "The ID of the resource.",
+	})
+}
+
+// identitySchema is the identity of a resource, from the description of each of its
+// attributes, which are named as they are in state. A resource nested under another, such
+// as a schedule's rotation, has the parent's ID in its identity too, rather than the two
+// joined into one import ID.
+func identitySchema(descriptions map[string]string) identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{}
+	for name, description := range descriptions {
+		attributes[name] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       description,
+		}
+	}
+
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// setIDIdentity records the ID as the resource's identity. Create, Read and Update must
+// all set it, or Terraform rejects the response. identity is nil where the resource is
+// used without identity support, such as in a unit test.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String, diags *diag.Diagnostics) {
+	if identity == nil {
+		return
+	}
+
+	diags.Append(identity.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setIdentityFromState records the resource's identity from the state just set, for an
+// identity whose attributes are named as they are in state.
+func setIdentityFromState(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics, names ...string) {
+	if identity == nil || diags.HasError() {
+		return
+	}
+
+	for _, name := range names {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, path.Root(name), &value)...)
+		diags.Append(identity.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// importStateByID imports a resource by the ID it was given, or the one in its identity
+// when imported with an `identity` block, and returns it.
+func importStateByID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) string {
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+
+	var id types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("id"), &id)...)
+
+	return id.ValueString()
+}
+
+// importID is the ID a resource is being imported by. When it's imported with an
+// `identity` block rather than an ID, that's the identity's attributes joined with sep,
+// in the order given, so a resource with a composite identity can parse either the same
+// way.
+func importID(ctx context.Context, req resource.ImportStateRequest, sep string, diags *diag.Diagnostics, names ...string) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+
+	parts := []string{}
+	for _, name := range names {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(name), &value)...)
+		parts = append(parts, value.ValueString())
+	}
+
+	return strings.Join(parts, sep)
+}
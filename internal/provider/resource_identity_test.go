@@ -0,0 +1,130 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResourcesHaveIdentity checks every importable resource can be imported with an
+// `identity` block, which needs it to declare an identity.
+func TestResourcesHaveIdentity(t *testing.T) {
+	for _, newResource := range (&IncidentProvider{}).Resources(context.Background()) {
+		res := newResource()
+
+		var metadata resource.MetadataResponse
+		res.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "incident"}, &metadata)
+
+		if _, ok := res.(resource.ResourceWithImportState); !ok {
+			continue
+		}
+		_, ok := res.(resource.ResourceWithIdentity)
+		assert.True(t, ok, "%s can be imported, but has no identity", metadata.TypeName)
+	}
+}
+
+// importByIdentity imports the resource as an `identity` block would, returning the
+// state it's imported with.
+func importByIdentity(t *testing.T, res resource.Resource, identity map[string]string) (tfsdk.State, resource.ImportStateResponse) {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	res.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	res.(resource.ResourceWithIdentity).IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	values := map[string]tftypes.Value{}
+	for name, value := range identity {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	identityValue := tfsdk.ResourceIdentity{
+		Schema: identityResp.IdentitySchema,
+		Raw:    tftypes.NewValue(identityResp.IdentitySchema.Type().TerraformType(ctx), values),
+	}
+
+	resp := resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &identityValue,
+	}
+	res.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{Identity: &identityValue}, &resp)
+
+	return resp.State, resp
+}
+
+func TestImportByIdentity(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a resource identified by its ID", func(t *testing.T) {
+		state, resp := importByIdentity(t, NewIncidentSecretResource(), map[string]string{"id": "01SECRET"})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("importing: %+v", resp.Diagnostics)
+		}
+
+		var id types.String
+		state.GetAttribute(ctx, path.Root("id"), &id)
+		assert.Equal(t, "01SECRET", id.ValueString())
+	})
+
+	t.Run("a resource identified by its parent's ID and its own", func(t *testing.T) {
+		state, resp := importByIdentity(t, NewIncidentScheduleRotationBetaResource(), map[string]string{
+			"schedule_id": "01SCHEDULE",
+			"id":          "01ROTATION",
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("importing: %+v", resp.Diagnostics)
+		}
+
+		var scheduleID, id types.String
+		state.GetAttribute(ctx, path.Root("schedule_id"), &scheduleID)
+		state.GetAttribute(ctx, path.Root("id"), &id)
+		assert.Equal(t, "01SCHEDULE", scheduleID.ValueString())
+		assert.Equal(t, "01ROTATION", id.ValueString())
+	})
+
+	t.Run("catalog entries identified by their catalog type", func(t *testing.T) {
+		state, resp := importByIdentity(t, NewIncidentCatalogEntriesResource(), map[string]string{"catalog_type_id": "01TYPE"})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("importing: %+v", resp.Diagnostics)
+		}
+
+		var id types.String
+		state.GetAttribute(ctx, path.Root("id"), &id)
+		assert.Equal(t, "01TYPE", id.ValueString())
+	})
+}
+
+func TestImportID(t *testing.T) {
+	ctx := context.Background()
+	identity := &tfsdk.ResourceIdentity{
+		Schema: identitySchema(map[string]string{"user_id": "", "id": ""}),
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+			"user_id": tftypes.String,
+			"id":      tftypes.String,
+		}}, map[string]tftypes.Value{
+			"user_id": tftypes.NewValue(tftypes.String, "01USER"),
+			"id":      tftypes.NewValue(tftypes.String, "01RULE"),
+		}),
+	}
+
+	t.Run("an import ID is used as given", func(t *testing.T) {
+		var diags diag.Diagnostics
+		assert.Equal(t, "01A:01B", importID(ctx, resource.ImportStateRequest{ID: "01A:01B", Identity: identity}, ":", &diags, "user_id", "id"))
+	})
+
+	t.Run("an identity is joined in the order given", func(t *testing.T) {
+		var diags diag.Diagnostics
+		assert.Equal(t, "01USER:01RULE", importID(ctx, resource.ImportStateRequest{Identity: identity}, ":", &diags, "user_id", "id"))
+		assert.False(t, diags.HasError(), "%+v", diags)
+	})
+}
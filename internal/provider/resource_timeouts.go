@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultTimeout is how long creating, reading, updating or deleting a resource may take,
+// retries included, when its timeouts block doesn't say.
+const defaultTimeout = 20 * time.Minute
+
+var timeoutsOpts = timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}
+
+// timeoutsBlock is the `timeouts` block every resource has, which limits how long each
+// operation on it may take.
+func timeoutsBlock(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeoutsOpts)
+}
+
+// withTimeout limits ctx to a timeout from a resource's timeouts block, such as
+// data.Timeouts.Create, or to defaultTimeout if that isn't set.
+func withTimeout(ctx context.Context, timeout func(context.Context, time.Duration) (time.Duration, diag.Diagnostics), diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	duration, timeoutDiags := timeout(ctx, defaultTimeout)
+	diags.Append(timeoutDiags...)
+
+	return context.WithTimeout(ctx, duration)
+}
+
+// nullTimeouts is an unset timeouts block, for a model with no plan or prior state to
+// take its timeouts from, such as one being imported.
+func nullTimeouts(ctx context.Context) timeouts.Value {
+	return timeouts.Value{
+		Object: types.ObjectNull(timeoutsBlock(ctx).Type().(timeouts.Type).AttrTypes),
+	}
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResourcesHaveTimeouts checks no resource is missing its timeouts block, which a
+// new resource could easily forget.
+func TestResourcesHaveTimeouts(t *testing.T) {
+	for _, newResource := range (&IncidentProvider{}).Resources(context.Background()) {
+		res := newResource()
+
+		var metadata resource.MetadataResponse
+		res.Metadata(context.Background(), resource.MetadataRequest{ProviderTypeName: "incident"}, &metadata)
+
+		var schemaResp resource.SchemaResponse
+		res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+		_, ok := schemaResp.Schema.Blocks["timeouts"]
+		assert.True(t, ok, "%s has no timeouts block", metadata.TypeName)
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("defaults when the block isn't set", func(t *testing.T) {
+		var diags diag.Diagnostics
+		timeoutCtx, cancel := withTimeout(ctx, nullTimeouts(ctx).Create, &diags)
+		defer cancel()
+
+		deadline, ok := timeoutCtx.Deadline()
+		assert.True(t, ok)
+		assert.WithinDuration(t, time.Now().Add(defaultTimeout), deadline, time.Minute)
+		assert.False(t, diags.HasError())
+	})
+
+	t.Run("takes the timeout for the operation", func(t *testing.T) {
+		value := timeouts.Value{
+			Object: types.ObjectValueMust(nullTimeouts(ctx).AttributeTypes(ctx), map[string]attr.Value{
+				"create": types.StringValue("30s"),
+				"read":   types.StringNull(),
+				"update": types.StringNull(),
+				"delete": types.StringNull(),
+			}),
+		}
+
+		var diags diag.Diagnostics
+		timeoutCtx, cancel := withTimeout(ctx, value.Create, &diags)
+		defer cancel()
+
+		deadline, _ := timeoutCtx.Deadline()
+		assert.WithinDuration(t, time.Now().Add(30*time.Second), deadline, 5*time.Second)
+		assert.False(t, diags.HasError())
+	})
+}
@@ -97,12 +97,12 @@ func (d *richTextDataSource) Read(ctx context.Context, req datasource.ReadReques
 	if err != nil {
 		// Bad markdown and an unknown feature set both 4xx, which client.go has already turned
 		// into an HTTPError carrying the API's message.
-		resp.Diagnostics.AddError("Unable to parse markdown", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to parse markdown", err)
 		return
 	}
 	// A 2xx we couldn't decode, rather than nil-panicking on it.
 	if result.JSON200 == nil {
-		resp.Diagnostics.AddError("Unable to parse markdown", string(result.Body))
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to parse markdown", fmt.Errorf("unexpected response: %s", result.Body))
 		return
 	}
 
@@ -111,7 +111,7 @@ func (d *richTextDataSource) Read(ctx context.Context, req datasource.ReadReques
 	// same bytes — otherwise the API's key order would show up as a diff.
 	documentJSON, err := json.Marshal(result.JSON200.Document.TextNode)
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to encode parsed document", err.Error())
+		addAPIError(ctx, &resp.Diagnostics, resp.State.Schema, "Client Error", "Unable to encode parsed document", err)
 		return
 	}
 	data.JSON = types.StringValue(string(documentJSON))
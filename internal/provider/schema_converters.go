@@ -74,6 +74,7 @@ func (r *IncidentWorkflowResource) buildModel(ctx context.Context, workflow clie
 	if prior != nil {
 		model.ConditionGroups.ReconcileOperations(prior.ConditionGroups)
 		model.Expressions.ReconcileOperations(prior.Expressions)
+		model.Timeouts = prior.Timeouts
 	}
 
 	return model
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/netip"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -53,3 +54,45 @@ func (v RFC3339TimestampValidator) Description(ctx context.Context) string {
 func (v RFC3339TimestampValidator) MarkdownDescription(ctx context.Context) string {
 	return "Value must be a valid RFC3339 timestamp (YYYY-MM-DDThh:mm:ssZ)"
 }
+
+// IPOrCIDRValidator validates that a string value is an IP address or a CIDR prefix,
+// written with no bits set beyond its length.
+type IPOrCIDRValidator struct{}
+
+func (v IPOrCIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if _, err := netip.ParseAddr(value); err == nil {
+		return
+	}
+	if prefix, err := netip.ParsePrefix(value); err == nil {
+		// The API stores a prefix by its network address, so 10.0.0.1/8 would come back
+		// as 10.0.0.0/8 and never match the config.
+		if prefix.Masked() != prefix {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Non-canonical CIDR Prefix",
+				fmt.Sprintf("The prefix %q has bits set beyond its length: write it as %q.", value, prefix.Masked()),
+			)
+		}
+
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid IP Address or CIDR Prefix",
+		fmt.Sprintf("The value %q is neither an IP address (such as 192.0.2.1) nor a CIDR prefix (such as 192.0.2.0/24).", value),
+	)
+}
+
+func (v IPOrCIDRValidator) Description(ctx context.Context) string {
+	return "Value must be an IP address or a CIDR prefix"
+}
+
+func (v IPOrCIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an IP address or a CIDR prefix"
+}
@@ -102,6 +102,78 @@ func TestTimestampValidatorWithNullAndUnknown(t *testing.T) {
 	assert.False(t, unknownResponse.Diagnostics.HasError(), "unknown values should not cause validation errors")
 }
 
+func TestIPOrCIDRValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         string
+		expectedError bool
+	}{
+		{
+			name:          "IPv4 address",
+			value:         "192.0.2.1",
+			expectedError: false,
+		},
+		{
+			name:          "IPv4 prefix",
+			value:         "192.0.2.0/24",
+			expectedError: false,
+		},
+		{
+			name:          "IPv6 address",
+			value:         "2001:db8::1",
+			expectedError: false,
+		},
+		{
+			name:          "IPv6 prefix",
+			value:         "2001:db8::/32",
+			expectedError: false,
+		},
+		{
+			name:          "prefix with host bits set",
+			value:         "10.0.0.1/8",
+			expectedError: true,
+		},
+		{
+			name:          "IPv6 prefix with host bits set",
+			value:         "2001:db8::1/32",
+			expectedError: true,
+		},
+		{
+			name:          "prefix length out of range",
+			value:         "192.0.2.0/33",
+			expectedError: true,
+		},
+		{
+			name:          "hostname",
+			value:         "example.com",
+			expectedError: true,
+		},
+		{
+			name:          "empty string",
+			value:         "",
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			request := validator.StringRequest{
+				ConfigValue: types.StringValue(tc.value),
+			}
+			response := validator.StringResponse{}
+
+			v := IPOrCIDRValidator{}
+			v.ValidateString(context.Background(), request, &response)
+
+			if tc.expectedError {
+				assert.True(t, response.Diagnostics.HasError(), "expected validation to fail")
+			} else {
+				assert.False(t, response.Diagnostics.HasError(), "expected validation to succeed")
+			}
+		})
+	}
+}
+
 func TestNonEmptyListValidator(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/alecthomas/kingpin/v2"
+
+	"github.com/incident-io/terraform-provider-incident/internal/client"
+	"github.com/incident-io/terraform-provider-incident/internal/export"
+)
+
+func main() {
+	app := kingpin.New("export", "Exports an incident.io organisation's configuration as Terraform").
+		Author("terraform-provider-incident")
+
+	apiKey := app.Flag("api-key", "API key for incident.io").Envar("INCIDENT_API_KEY").Required().String()
+	endpoint := app.Flag("endpoint", "URL of the incident.io API").Envar("INCIDENT_ENDPOINT").Default("https://api.incident.io").String()
+	outputDir := app.Flag("output", "Directory to write the .tf files to").Short('o').Default(".").String()
+	types := app.Flag("type", "Only export resources of this type, such as incident_workflow (repeatable)").Short('t').Strings()
+
+	kingpin.MustParse(app.Parse(os.Args[1:]))
+
+	ctx := context.Background()
+
+	// Exporting only ever reads, so refuse anything else.
+	apiClient, err := client.New(ctx, *apiKey, *endpoint, "export", client.WithReadOnly())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating client: %v\n", err)
+		os.Exit(1)
+	}
+
+	exporter := export.New(apiClient)
+	exporter.Types = *types
+
+	result, err := exporter.Export(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error exporting: %v\n", err)
+		os.Exit(1)
+	}
+
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+		os.Exit(1)
+	}
+
+	fileNames := []string{}
+	for fileName := range result.Files {
+		fileNames = append(fileNames, fileName)
+	}
+	sort.Strings(fileNames)
+
+	for _, fileName := range fileNames {
+		outputFile := filepath.Join(*outputDir, fileName)
+		if err := os.WriteFile(outputFile, result.Files[fileName], 0644); err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing to file: %v\n", err)
+			os.Exit(1)
+		}
+		fmt.Println(outputFile)
+	}
+
+	for _, skipped := range result.Skipped {
+		fmt.Fprintf(os.Stderr, "Skipped %s\n", skipped)
+	}
+}